@@ -87,6 +87,56 @@ func BenchmarkRFC3339InLocation(b *testing.B) {
 	}
 }
 
+func BenchmarkParsePrefix(b *testing.B) {
+	line := []byte(time.Now().UTC().Format(time.RFC3339Nano) + " INFO request completed in 12ms status=200")
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := parsetime.ParsePrefix(line); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValid(b *testing.B) {
+	now := []byte(time.Now().Local().Format(time.RFC3339Nano))
+
+	for i := 0; i < b.N; i++ {
+		if !parsetime.Valid(now) {
+			b.Fatal("invalid")
+		}
+	}
+}
+
+func BenchmarkValidRFC3339(b *testing.B) {
+	now := []byte(time.Now().Local().Format(time.RFC3339Nano))
+
+	for i := 0; i < b.N; i++ {
+		if !parsetime.ValidRFC3339(now) {
+			b.Fatal("invalid")
+		}
+	}
+}
+
+func BenchmarkParseStrict(b *testing.B) {
+	now := []byte(time.Now().Local().Format(time.RFC3339Nano))
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parsetime.ParseStrictBytes(now); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseInstant(b *testing.B) {
+	now := []byte("2006-01-02T15:04:05.123456789012345678+07:00")
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parsetime.ParseInstantBytes(now); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkGoMultiFormat(b *testing.B) {
 	now := time.Now().Local().Format(time.RFC3339Nano)
 
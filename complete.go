@@ -0,0 +1,195 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Completion is the choice among the dates that complete an incomplete time.
+type Completion int
+
+const (
+	// CompleteNearest chooses the date nearest to the reference time,
+	// or the earlier one of two equally near.
+	CompleteNearest Completion = iota
+
+	// CompletePast chooses the latest date that is not after the reference time.
+	CompletePast
+
+	// CompleteFuture chooses the earliest date that is not before the reference time.
+	CompleteFuture
+)
+
+// ParseIncomplete parses a time that lacks its date, or part of it, and completes
+// it from the reference time ref according to c. The accepted forms are
+//
+//	15:04:05.999999999Z07:00  time of day, with the fraction and time zone of Parse
+//	Jan 02 15:04:05           month and day without year, as in syslog, with the
+//	                          day optionally padded by a space as in "Jan  2"
+//	021504Z                   day, hour and minute in UTC, as in METAR reports
+//
+// Missing components are taken from the date of ref, stepping to the previous or
+// next day, month or year as c requires, so that for example "23:59:00" completed
+// as CompletePast from shortly after midnight is on the previous day, and "Feb 29"
+// is in a leap year.
+//
+// In the absence of time zone information, ParseIncomplete interprets the time as
+// wall clock time in the location of ref. The result is in the location of ref, or
+// in the time zone given in s.
+func ParseIncomplete(s string, ref time.Time, c Completion) (time.Time, error) {
+	return defaultParser.parseIncomplete([]byte(s), ref, c)
+}
+
+// ParseIncomplete is like the package function ParseIncomplete. The options of p apply
+// to the time of day and time zone, and Trim and Unicode to all of s, but Strict does not
+// apply, and the accepted window applies to the completed time.
+func (p *Parser) ParseIncomplete(s string, ref time.Time, c Completion) (time.Time, error) {
+	return p.parseIncomplete([]byte(s), ref, c)
+}
+
+// maxCompletionSteps bounds the search for a date, which takes at most 8 years
+// for February 29 around a century that is not a leap year.
+const maxCompletionSteps = 8
+
+func (p *Parser) parseIncomplete(s []byte, ref time.Time, c Completion) (time.Time, error) {
+	var month, day int
+
+	if p.Trim {
+		s = trim(s)
+	}
+	var ubuf [64]byte
+	if p.Unicode && !isASCII(s) {
+		s = normalizeUnicode(ubuf[:0], s)
+	}
+
+	// The time of day is parsed on the unix epoch day, giving the seconds since midnight.
+	var buf [80]byte
+	clock := append(buf[:0], "1970-01-01"...)
+
+	switch {
+	case len(s) == 7 && s[6] == 'Z':
+		// DDhhmmZ
+		day = atoi2MinMax(s[0:2], 1, 31)
+		if day == -1 {
+			return time.Time{}, errParse
+		}
+		clock = append(clock, 'T', s[2], s[3], ':', s[4], s[5], ':', '0', '0', 'Z')
+	case len(s) > 6 && (s[0]|0x20) >= 'a' && (s[0]|0x20) <= 'z':
+		// Jan 02 15:04:05
+		month = lookupMonth(s[:3])
+		if month == 0 || s[3] != ' ' {
+			return time.Time{}, errParse
+		}
+
+		i := 4
+		if s[i] == ' ' {
+			i++
+		}
+		for ; i < len(s) && !nd(s[i]); i++ {
+			day = day*10 + int(s[i]-'0')
+		}
+		if day < 1 || day > 31 || i-4 > 2 || i == len(s) || s[i] != ' ' {
+			return time.Time{}, errParse
+		}
+		clock = append(clock, s[i:]...)
+	default:
+		clock = append(append(clock, 'T'), s...)
+	}
+
+	q := *p
+	q.Trim, q.Unicode, q.Strict = false, false, false
+	q.NotBefore, q.NotAfter, q.MaxPast, q.MaxFuture = time.Time{}, time.Time{}, 0, 0
+
+	var d details
+	t, err := q.parse(clock, 0, &d)
+	if err != nil {
+		return time.Time{}, err
+	}
+	sec := int(t.Unix()) + d.offset
+	nsec := t.Nanosecond()
+
+	// A location named in s gives the offset on the completed date, rather than on the
+	// epoch day.
+	zone := ref.Location()
+	switch {
+	case d.loc != nil:
+		zone = d.loc
+	case d.zoned:
+		zone = fixedZone(d.offset)
+	}
+	ry, rm, rd := ref.In(zone).Date()
+
+	// candidate returns the completion k days, months or years after that of ref.
+	candidate := func(k int) (time.Time, bool) {
+		y, m, dd := ry, int(rm), rd
+		switch {
+		case month != 0:
+			y, m, dd = y+k, month, day
+		case day != 0:
+			m, dd = m+k, day
+			y, m = y+(m-1)/12, (m-1)%12+1
+			if m < 1 {
+				y, m = y-1, m+12
+			}
+		default:
+			dd += k
+		}
+
+		if (month != 0 || day != 0) && dd > daysIn(m, y) {
+			return time.Time{}, false
+		}
+		return time.Date(y, time.Month(m), dd, 0, 0, sec, nsec, zone), true
+	}
+
+	var past, future time.Time
+	if c != CompleteFuture {
+		for k := 1; k >= -maxCompletionSteps; k-- {
+			if t, ok := candidate(k); ok && !t.After(ref) {
+				past = t
+				break
+			}
+		}
+	}
+	if c != CompletePast {
+		for k := -1; k <= maxCompletionSteps; k++ {
+			if t, ok := candidate(k); ok && !t.Before(ref) {
+				future = t
+				break
+			}
+		}
+	}
+
+	switch {
+	case c == CompletePast || future.IsZero():
+		t = past
+	case c == CompleteFuture || past.IsZero():
+		t = future
+	case future.Sub(ref) < ref.Sub(past):
+		t = future
+	default:
+		t = past
+	}
+
+	if t.IsZero() {
+		return time.Time{}, errParse
+	}
+	if !p.inWindow(t) {
+		return time.Time{}, ErrOutOfWindow
+	}
+	return t, nil
+}
+
+var monthNames = [...]string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// lookupMonth returns the month of a three letter English abbreviation in any case, or 0.
+func lookupMonth(s []byte) int {
+	if len(s) != 3 {
+		return 0
+	}
+
+	for i, name := range monthNames {
+		if s[0]|0x20 == name[0] && s[1]|0x20 == name[1] && s[2]|0x20 == name[2] {
+			return i + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,119 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseIncomplete(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	ref := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value  string
+		ref    time.Time
+		c      Completion
+		expect time.Time
+		err    bool
+	}{
+		// Time of day.
+		{"15:04:05.123", ref, CompleteNearest, time.Date(2026, 10, 16, 15, 4, 5, 123000000, time.UTC), false},
+		{"15:04:05.123", ref, CompletePast, time.Date(2026, 10, 15, 15, 4, 5, 123000000, time.UTC), false},
+		{"15:04:05.123", ref, CompleteFuture, time.Date(2026, 10, 16, 15, 4, 5, 123000000, time.UTC), false},
+		{"23:59:00", time.Date(2026, 12, 31, 23, 59, 30, 0, time.UTC), CompletePast, time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC), false},
+		{"23:59:00", time.Date(2027, 1, 1, 0, 0, 30, 0, time.UTC), CompleteNearest, time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC), false},
+		{"00:01:00", time.Date(2026, 12, 31, 23, 59, 30, 0, time.UTC), CompleteNearest, time.Date(2027, 1, 1, 0, 1, 0, 0, time.UTC), false},
+		{"00:01:00", time.Date(2026, 12, 31, 23, 59, 30, 0, time.UTC), CompletePast, time.Date(2026, 12, 31, 0, 1, 0, 0, time.UTC), false},
+		{"12:00:00", ref, CompletePast, ref, false},
+		{"12:00:00", ref, CompleteFuture, ref, false},
+		{"00:30:00+02:00", ref, CompleteFuture, time.Date(2026, 10, 16, 22, 30, 0, 0, time.UTC), false},
+		{"10:00:00", time.Date(2026, 10, 16, 12, 0, 0, 0, ny), CompleteFuture, time.Date(2026, 10, 17, 10, 0, 0, 0, ny), false},
+
+		// Month and day.
+		{"Jan 02 15:04:05", ref, CompleteNearest, time.Date(2027, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{"Jan 02 15:04:05", ref, CompletePast, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{"Jan  2 15:04:05", ref, CompletePast, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{"oct 16 11:00:00", ref, CompleteNearest, time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC), false},
+		{"Dec 31 23:59:59", time.Date(2027, 1, 1, 0, 0, 5, 0, time.UTC), CompleteNearest, time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC), false},
+		{"Feb 29 00:00:00", ref, CompletePast, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"Feb 29 00:00:00", ref, CompleteFuture, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"Feb 29 00:00:00", time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC), CompleteFuture, time.Date(2104, 2, 29, 0, 0, 0, 0, time.UTC), false},
+
+		// Day, hour and minute.
+		{"021504Z", ref, CompleteNearest, time.Date(2026, 10, 2, 15, 4, 0, 0, time.UTC), false},
+		{"021504Z", ref, CompleteFuture, time.Date(2026, 11, 2, 15, 4, 0, 0, time.UTC), false},
+		{"021504Z", ref, CompletePast, time.Date(2026, 10, 2, 15, 4, 0, 0, time.UTC), false},
+		{"161150Z", ref, CompleteNearest, time.Date(2026, 10, 16, 11, 50, 0, 0, time.UTC), false},
+		{"312350Z", time.Date(2027, 1, 1, 0, 10, 0, 0, time.UTC), CompleteNearest, time.Date(2026, 12, 31, 23, 50, 0, 0, time.UTC), false},
+		{"310000Z", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), CompletePast, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), false},
+		{"310000Z", time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC), CompleteNearest, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), false},
+
+		{"", ref, CompleteNearest, time.Time{}, true},
+		{"15:04", ref, CompleteNearest, time.Time{}, true},
+		{"25:00:00", ref, CompleteNearest, time.Time{}, true},
+		{"Foo 02 15:04:05", ref, CompleteNearest, time.Time{}, true},
+		{"Jan 32 15:04:05", ref, CompleteNearest, time.Time{}, true},
+		{"Jan 002 15:04:05", ref, CompleteNearest, time.Time{}, true},
+		{"Jan 02", ref, CompleteNearest, time.Time{}, true},
+		{"321504Z", ref, CompleteNearest, time.Time{}, true},
+		{"021560Z", ref, CompleteNearest, time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseIncomplete(tt.value, tt.ref, tt.c)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %s, expect: %s, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserIncomplete(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	ref := time.Date(2026, 7, 16, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{ZoneNames: true}, "15:04:05 America/New_York", time.Date(2026, 7, 16, 15, 4, 5, 0, ny), false},
+		{Parser{ZoneNames: true}, "Jan 02 15:04:05 America/New_York", time.Date(2026, 1, 2, 15, 4, 5, 0, ny), false},
+		{Parser{Trim: true}, ` "15:04:05Z" `, time.Date(2026, 7, 16, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Unicode: true}, "１５：０４：０５", time.Date(2026, 7, 16, 15, 4, 5, 0, time.UTC), false},
+		{Parser{TimeSeparators: "-"}, "15-04-05", time.Date(2026, 7, 16, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Strict: true}, "15:04:05", time.Date(2026, 7, 16, 15, 4, 5, 0, time.UTC), false},
+
+		{Parser{}, "15:04:05 America/New_York", time.Time{}, true},
+		{Parser{}, ` "15:04:05Z" `, time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.ParseIncomplete(tt.value, ref, CompletePast)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		_, offset := got.Zone()
+		_, expectOffset := tt.expect.Zone()
+		if !tt.expect.Equal(got) || offset != expectOffset {
+			t.Fatalf("case %d: got: %s, expect: %s, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
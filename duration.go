@@ -0,0 +1,467 @@
+package parsetime
+
+import (
+	"math"
+	"time"
+)
+
+// Duration is an ISO 8601 duration, such as "P1Y2M3DT4H5M6.5S".
+//
+// Years, Months, Weeks and Days are nominal: their length depends on the time
+// they are added to. Hours, Minutes, Seconds and Nanoseconds are exact.
+// Components are non-negative, and Negative reverses the whole duration.
+type Duration struct {
+	Negative bool
+
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// Designator order, and the number of units of the next smaller component in one unit,
+// used to carry a fraction of the last component into the smaller components.
+const (
+	durYear = iota
+	durMonth
+	durWeek
+	durDay
+	durHour
+	durMinute
+	durSecond
+)
+
+var durationCarry = [...]struct {
+	next  int
+	ratio int
+}{
+	durYear:   {durMonth, 12},
+	durMonth:  {durDay, 30},
+	durWeek:   {durDay, 7},
+	durDay:    {durHour, 24},
+	durHour:   {durMinute, 60},
+	durMinute: {durSecond, 60},
+}
+
+// ParseDuration parses an ISO 8601 duration.
+//
+// Both the designator format ("P1Y2M3DT4H5M6.5S", "PT0.001S", "P2W") and the
+// alternative format ("P0001-02-03T04:05:06.5", "P00010203T040506") are accepted,
+// with an optional leading sign ("-P1D").
+//
+// The last component may have a decimal fraction, using either '.' or ','.
+// A fraction of seconds is kept in Nanoseconds; a fraction of any other component
+// is carried into the smaller components, counting a year as 12 months, a month
+// as 30 days, a week as 7 days, a day as 24 hours, and an hour and a minute as 60
+// of the next unit. For example "P0.5M" is 15 days, and "PT1.5H" is 1 hour 30 minutes.
+func ParseDuration(s string) (Duration, error) {
+	return parseDuration([]byte(s))
+}
+
+// ParseDurationBytes is like ParseDuration but accepting bytes.
+func ParseDurationBytes(s []byte) (Duration, error) {
+	return parseDuration(s)
+}
+
+func parseDuration(s []byte) (Duration, error) {
+	var d Duration
+
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		d.Negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	if len(s) < 2 || s[0] != 'P' {
+		return Duration{}, errParse
+	}
+	s = s[1:]
+
+	// The alternative format starts with a four digit year followed by a hyphen,
+	// or is eight digits optionally followed by a time.
+	n := 0
+	for n < len(s) && !nd(s[n]) {
+		n++
+	}
+	if n == 4 && len(s) > 4 && s[4] == '-' || n == 8 && (len(s) == 8 || s[8] == 'T') {
+		if !parseDurationAlternative(s, &d) {
+			return Duration{}, errParse
+		}
+		return d, nil
+	}
+
+	last := -1
+	inTime := false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			// The time designator is followed by at least one component.
+			if inTime || len(s) == 1 {
+				return Duration{}, errParse
+			}
+			inTime = true
+			s = s[1:]
+		}
+
+		val, n := atoiDuration(s)
+		if n == 0 {
+			return Duration{}, errParse
+		}
+		s = s[n:]
+
+		frac := 0
+		hasFrac := len(s) > 0 && (s[0] == '.' || s[0] == ',')
+		if hasFrac {
+			frac, n = atoiFrac(s[1:])
+			if n == 0 {
+				return Duration{}, errParse
+			}
+			s = s[n+1:]
+		}
+
+		if len(s) == 0 {
+			return Duration{}, errParse
+		}
+
+		unit := -1
+		switch {
+		case !inTime && s[0] == 'Y':
+			unit = durYear
+		case !inTime && s[0] == 'M':
+			unit = durMonth
+		case !inTime && s[0] == 'W':
+			unit = durWeek
+		case !inTime && s[0] == 'D':
+			unit = durDay
+		case inTime && s[0] == 'H':
+			unit = durHour
+		case inTime && s[0] == 'M':
+			unit = durMinute
+		case inTime && s[0] == 'S':
+			unit = durSecond
+		}
+		s = s[1:]
+
+		// Components are in order, and only the last may have a fraction.
+		if unit <= last || hasFrac && len(s) > 0 {
+			return Duration{}, errParse
+		}
+		last = unit
+
+		d.set(unit, val)
+		if hasFrac {
+			d.carry(unit, frac)
+		}
+	}
+
+	if last == -1 {
+		return Duration{}, errParse
+	}
+
+	return d, nil
+}
+
+// parseDurationAlternative parses the alternative format "YYYY-MM-DDThh:mm:ss" or
+// "YYYYMMDDThhmmss", with an optional fraction of seconds, following the 'P'.
+func parseDurationAlternative(s []byte, d *Duration) bool {
+	extended := s[4] == '-'
+
+	if nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
+		return false
+	}
+	d.Years = int(s[0]-'0')*1e3 + int(s[1]-'0')*1e2 + int(s[2]-'0')*1e1 + int(s[3]-'0')
+
+	if extended {
+		if len(s) < 10 || s[7] != '-' {
+			return false
+		}
+		d.Months = atoi2MinMax(s[5:7], 0, 12)
+		d.Days = atoi2MinMax(s[8:10], 0, 30)
+		s = s[10:]
+	} else {
+		d.Months = atoi2MinMax(s[4:6], 0, 12)
+		d.Days = atoi2MinMax(s[6:8], 0, 30)
+		s = s[8:]
+	}
+	if d.Months == -1 || d.Days == -1 {
+		return false
+	}
+
+	if len(s) == 0 {
+		return true
+	}
+
+	if extended {
+		if len(s) < 9 || s[0] != 'T' || s[3] != ':' || s[6] != ':' {
+			return false
+		}
+		d.Hours = atoi2MinMax(s[1:3], 0, 24)
+		d.Minutes = atoi2MinMax(s[4:6], 0, 59)
+		d.Seconds = atoi2MinMax(s[7:9], 0, 59)
+		s = s[9:]
+	} else {
+		if len(s) < 7 || s[0] != 'T' {
+			return false
+		}
+		d.Hours = atoi2MinMax(s[1:3], 0, 24)
+		d.Minutes = atoi2MinMax(s[3:5], 0, 59)
+		d.Seconds = atoi2MinMax(s[5:7], 0, 59)
+		s = s[7:]
+	}
+	if d.Hours == -1 || d.Minutes == -1 || d.Seconds == -1 {
+		return false
+	}
+
+	if len(s) == 0 {
+		return true
+	}
+
+	if s[0] != '.' && s[0] != ',' {
+		return false
+	}
+	frac, n := atoiFrac(s[1:])
+	d.Nanoseconds = frac
+	return n > 0 && n+1 == len(s)
+}
+
+// set sets the component unit to val.
+func (d *Duration) set(unit, val int) {
+	switch unit {
+	case durYear:
+		d.Years = val
+	case durMonth:
+		d.Months = val
+	case durWeek:
+		d.Weeks = val
+	case durDay:
+		d.Days = val
+	case durHour:
+		d.Hours = val
+	case durMinute:
+		d.Minutes = val
+	case durSecond:
+		d.Seconds = val
+	}
+}
+
+// carry adds frac billionths of the component unit to the smaller components.
+func (d *Duration) carry(unit, frac int) {
+	for unit != durSecond {
+		c := durationCarry[unit]
+		frac *= c.ratio
+		unit = c.next
+
+		switch unit {
+		case durMonth:
+			d.Months += frac / 1e9
+		case durDay:
+			d.Days += frac / 1e9
+		case durHour:
+			d.Hours += frac / 1e9
+		case durMinute:
+			d.Minutes += frac / 1e9
+		case durSecond:
+			d.Seconds += frac / 1e9
+		}
+		frac %= 1e9
+	}
+
+	d.Nanoseconds += frac
+}
+
+// String returns the ISO 8601 designator format of d, such as "P1Y2M3DT4H5M6.5S".
+// The zero duration is "PT0S".
+func (d Duration) String() string {
+	return string(d.AppendFormat(make([]byte, 0, 32)))
+}
+
+// AppendFormat is like String but appends the duration to b and returns the extended buffer.
+func (d Duration) AppendFormat(b []byte) []byte {
+	if d.Negative {
+		b = append(b, '-')
+	}
+	b = append(b, 'P')
+
+	if d.Years != 0 {
+		b = append(appendInt(b, d.Years, 0), 'Y')
+	}
+	if d.Months != 0 {
+		b = append(appendInt(b, d.Months, 0), 'M')
+	}
+	if d.Weeks != 0 {
+		b = append(appendInt(b, d.Weeks, 0), 'W')
+	}
+	if d.Days != 0 {
+		b = append(appendInt(b, d.Days, 0), 'D')
+	}
+
+	sec, nsec := d.Seconds+d.Nanoseconds/1e9, d.Nanoseconds%1e9
+	if d.Hours == 0 && d.Minutes == 0 && sec == 0 && nsec == 0 {
+		if b[len(b)-1] == 'P' {
+			b = append(b, 'T', '0', 'S')
+		}
+		return b
+	}
+
+	b = append(b, 'T')
+	if d.Hours != 0 {
+		b = append(appendInt(b, d.Hours, 0), 'H')
+	}
+	if d.Minutes != 0 {
+		b = append(appendInt(b, d.Minutes, 0), 'M')
+	}
+	if sec != 0 || nsec != 0 {
+		b = appendInt(b, sec, 0)
+		if nsec != 0 {
+			b = appendFrac(append(b, '.'), nsec)
+		}
+		b = append(b, 'S')
+	}
+	return b
+}
+
+// Exact returns d as a time.Duration.
+// The result is false if d has nominal components, which have no fixed length,
+// or if d overflows time.Duration.
+func (d Duration) Exact() (time.Duration, bool) {
+	if d.Years != 0 || d.Months != 0 || d.Weeks != 0 || d.Days != 0 {
+		return 0, false
+	}
+
+	var x int64
+	for _, c := range [...]struct {
+		val  int
+		unit time.Duration
+	}{
+		{d.Hours, time.Hour},
+		{d.Minutes, time.Minute},
+		{d.Seconds, time.Second},
+		{d.Nanoseconds, time.Nanosecond},
+	} {
+		if c.val < 0 || int64(c.val) > (math.MaxInt64-x)/int64(c.unit) {
+			return 0, false
+		}
+		x += int64(c.val) * int64(c.unit)
+	}
+
+	if d.Negative {
+		x = -x
+	}
+	return time.Duration(x), true
+}
+
+// AddTo returns t plus d, following the algorithm of XML Schema.
+//
+// Years and months are added first, and the day is clamped to the last day of
+// the resulting month, so that adding "P1M" to January 31 gives February 28 or 29.
+// Weeks and days are then added on the wall clock of t's location, keeping the
+// time of day across daylight saving transitions, and finally the exact time is added.
+// Without years, months, weeks or days, t is left as is on the wall clock, even in
+// an hour repeated by a transition.
+//
+// An exact time beyond a time.Duration, about 292 years, is added in seconds rather
+// than wrapping, and saturates at about 73 billion years from the unix epoch.
+func (d Duration) AddTo(t time.Time) time.Time {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+
+	if d.Years != 0 || d.Months != 0 || d.Weeks != 0 || d.Days != 0 {
+		year, month, day := t.Date()
+		hour, min, sec := t.Clock()
+
+		months := year*12 + int(month) - 1 + sign*(d.Years*12+d.Months)
+		year, m := months/12, months%12
+		if m < 0 {
+			year, m = year-1, m+12
+		}
+		month = time.Month(m + 1)
+
+		if n := daysIn(int(month), year); day > n {
+			day = n
+		}
+		day += sign * (d.Weeks*7 + d.Days)
+
+		t = time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+	}
+
+	exact := Duration{Negative: d.Negative, Hours: d.Hours, Minutes: d.Minutes, Seconds: d.Seconds, Nanoseconds: d.Nanoseconds}
+	if x, ok := exact.Exact(); ok {
+		return t.Add(x)
+	}
+	return exact.addSeconds(t)
+}
+
+// maxExactSeconds bounds the exact time added by addSeconds, and the resulting seconds
+// since the unix epoch, well within the range of time.Time.
+const maxExactSeconds = math.MaxInt64 / 4
+
+// addSeconds returns t plus the exact duration d, which is too long for a time.Duration,
+// adding it in seconds and saturating at maxExactSeconds.
+func (d Duration) addSeconds(t time.Time) time.Time {
+	x := int64(d.Nanoseconds / 1e9)
+	for _, c := range [...]struct {
+		val  int
+		unit int64
+	}{
+		{d.Hours, 3600},
+		{d.Minutes, 60},
+		{d.Seconds, 1},
+	} {
+		if int64(c.val) > (maxExactSeconds-x)/c.unit {
+			x = maxExactSeconds
+			break
+		}
+		x += int64(c.val) * c.unit
+	}
+
+	nsec := int64(d.Nanoseconds % 1e9)
+	unix := t.Unix()
+	if d.Negative {
+		unix = max(unix-x, -maxExactSeconds)
+		nsec = -nsec
+	} else {
+		unix = min(unix+x, maxExactSeconds)
+	}
+	return time.Unix(unix, int64(t.Nanosecond())+nsec).In(t.Location())
+}
+
+// atoiDuration parses the leading decimal digits of s, returning the value and the
+// number of digits, which is 0 if s does not start with a digit or the value overflows.
+func atoiDuration(s []byte) (x int, n int) {
+	for ; n < len(s) && !nd(s[n]); n++ {
+		if x > (math.MaxInt-9)/10 {
+			return 0, 0
+		}
+		x = x*10 + int(s[n]-'0')
+	}
+	return x, n
+}
+
+// negate returns -d.
+func (d Duration) negate() Duration {
+	d.Negative = !d.Negative
+	return d
+}
+
+// mul returns k times d.
+func (d Duration) mul(k int) Duration {
+	if k < 0 {
+		d.Negative = !d.Negative
+		k = -k
+	}
+
+	d.Years *= k
+	d.Months *= k
+	d.Weeks *= k
+	d.Days *= k
+	d.Hours *= k
+	d.Minutes *= k
+	d.Seconds *= k
+	d.Nanoseconds *= k
+	return d
+}
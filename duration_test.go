@@ -0,0 +1,173 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value  string
+		expect Duration
+		err    bool
+	}{
+		{"P1Y2M3DT4H5M6.5S", Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6, Nanoseconds: 500000000}, false},
+		{"PT0.001S", Duration{Nanoseconds: 1000000}, false},
+		{"PT0,001S", Duration{Nanoseconds: 1000000}, false},
+		{"P2W", Duration{Weeks: 2}, false},
+		{"P1D", Duration{Days: 1}, false},
+		{"PT36H", Duration{Hours: 36}, false},
+		{"PT0S", Duration{}, false},
+		{"P0D", Duration{}, false},
+		{"-P1DT1H", Duration{Negative: true, Days: 1, Hours: 1}, false},
+		{"+P1M", Duration{Months: 1}, false},
+		{"P1M", Duration{Months: 1}, false},
+		{"PT1M", Duration{Minutes: 1}, false},
+		{"P1Y0.5M", Duration{Years: 1, Days: 15}, false},
+		{"P0.5Y", Duration{Months: 6}, false},
+		{"P1.5W", Duration{Weeks: 1, Days: 3, Hours: 12}, false},
+		{"P1.5D", Duration{Days: 1, Hours: 12}, false},
+		{"PT1.5H", Duration{Hours: 1, Minutes: 30}, false},
+		{"PT0.1M", Duration{Seconds: 6}, false},
+		{"PT1.0000000015S", Duration{Seconds: 1, Nanoseconds: 1}, false},
+		{"P0003-06-04T12:30:05", Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5}, false},
+		{"P0003-06-04T12:30:05.25", Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5, Nanoseconds: 250000000}, false},
+		{"P0003-06-04", Duration{Years: 3, Months: 6, Days: 4}, false},
+		{"P00030604T123005", Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5}, false},
+		{"P00030604", Duration{Years: 3, Months: 6, Days: 4}, false},
+
+		{"", Duration{}, true},
+		{"P", Duration{}, true},
+		{"PT", Duration{}, true},
+		{"P1YT", Duration{}, true},
+		{"1Y", Duration{}, true},
+		{"P1", Duration{}, true},
+		{"P1H", Duration{}, true},
+		{"PT1D", Duration{}, true},
+		{"P1M1Y", Duration{}, true},
+		{"P1Y1Y", Duration{}, true},
+		{"PT1S1M", Duration{}, true},
+		{"P1.5Y1M", Duration{}, true},
+		{"PT1.S", Duration{}, true},
+		{"PT.5S", Duration{}, true},
+		{"P-1D", Duration{}, true},
+		{"PT1HT1M", Duration{}, true},
+		{"p1d", Duration{}, true},
+		{"P99999999999999999999D", Duration{}, true},
+		{"P0003-13-04", Duration{}, true},
+		{"P0003-06-04T12:60:05", Duration{}, true},
+		{"P0003-06-04T12:30", Duration{}, true},
+		{"P0003-06-04T12:30:05.", Duration{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseDuration(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if got != tt.expect {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestDurationString(t *testing.T) {
+	tests := []struct {
+		value  Duration
+		expect string
+	}{
+		{Duration{}, "PT0S"},
+		{Duration{Weeks: 2}, "P2W"},
+		{Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6, Nanoseconds: 500000000}, "P1Y2M3DT4H5M6.5S"},
+		{Duration{Nanoseconds: 1000000}, "PT0.001S"},
+		{Duration{Nanoseconds: 1500000000}, "PT1.5S"},
+		{Duration{Negative: true, Days: 1}, "-P1D"},
+		{Duration{Days: 1, Minutes: 1}, "P1DT1M"},
+	}
+
+	for i, tt := range tests {
+		if got := tt.value.String(); got != tt.expect {
+			t.Fatalf("case %d: got: %s, expect: %s", i, got, tt.expect)
+		}
+
+		got, err := ParseDuration(tt.expect)
+		if err != nil {
+			t.Fatalf("case %d: got error: %s", i, err)
+		}
+		if got.String() != tt.expect {
+			t.Fatalf("case %d: round trip got: %s, expect: %s", i, got, tt.expect)
+		}
+	}
+}
+
+func TestDurationExact(t *testing.T) {
+	tests := []struct {
+		value  string
+		expect time.Duration
+		ok     bool
+	}{
+		{"PT4H5M6.5S", 4*time.Hour + 5*time.Minute + 6500*time.Millisecond, true},
+		{"-PT1M", -time.Minute, true},
+		{"PT0S", 0, true},
+		{"P1D", 0, false},
+		{"P1W", 0, false},
+		{"PT9999999999H", 0, false},
+	}
+
+	for i, tt := range tests {
+		d, err := ParseDuration(tt.value)
+		if err != nil {
+			t.Fatalf("case %d: got error: %s", i, err)
+		}
+
+		got, ok := d.Exact()
+		if ok != tt.ok || got != tt.expect {
+			t.Fatalf("case %d: got: %s %v, expect: %s %v", i, got, ok, tt.expect, tt.ok)
+		}
+	}
+}
+
+func TestDurationAddTo(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		start  time.Time
+		value  string
+		expect time.Time
+	}{
+		{time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC), "P1M", time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC), "P1M", time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC), "P1Y", time.Date(2025, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 31, 10, 0, 0, 0, time.UTC), "-P1M", time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC), "-P1Y2M", time.Date(2024, 11, 15, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC), "P1M1D", time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC), "PT1H0.5S", time.Date(2027, 1, 1, 0, 0, 0, 500000000, time.UTC)},
+		{time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), "P1W", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 7, 12, 0, 0, 0, ny), "P1D", time.Date(2026, 3, 8, 12, 0, 0, 0, ny)},
+		{time.Date(2026, 3, 7, 12, 0, 0, 0, ny), "PT24H", time.Date(2026, 3, 8, 13, 0, 0, 0, ny)},
+		{time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC).In(ny), "PT0S", time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC)},
+		{time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC).In(ny), "PT1H", time.Date(2026, 11, 1, 7, 30, 0, 0, time.UTC)},
+		{time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC).In(ny), "PT1H", time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC)},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "PT1099511627776H", time.Unix(1099511627776*3600, 0)},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "-PT1099511627776H0.5S", time.Unix(-1099511627776*3600-1, 5e8)},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "PT922337203685477580H", time.Unix(maxExactSeconds, 0)},
+	}
+
+	for i, tt := range tests {
+		d, err := ParseDuration(tt.value)
+		if err != nil {
+			t.Fatalf("case %d: got error: %s", i, err)
+		}
+
+		if got := d.AddTo(tt.start); !got.Equal(tt.expect) {
+			t.Fatalf("case %d: got: %s, expect: %s", i, got, tt.expect)
+		}
+	}
+}
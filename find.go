@@ -0,0 +1,131 @@
+package parsetime
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// MatchFormat is the format of a timestamp found by FindAll.
+type MatchFormat int
+
+const (
+	// MatchDate is a calendar date, as in "2006-01-02", or a reduced precision one.
+	MatchDate MatchFormat = iota + 1
+
+	// MatchDateTime is a calendar date and time without time zone, as in
+	// "2006-01-02 15:04:05.000".
+	MatchDateTime
+
+	// MatchDateTimeZone is a calendar date and time with a time zone, as in
+	// "2006-01-02T15:04:05Z" or "2006-01-02 15:04:05 PST".
+	MatchDateTimeZone
+
+	// MatchWeek is a week date, optionally followed by a time, as in "2006-W01-1".
+	MatchWeek
+)
+
+// Match is a timestamp found in a text.
+type Match struct {
+	// Start and End are the byte offsets of the timestamp in the text.
+	Start, End int
+
+	Time   time.Time
+	Format MatchFormat
+}
+
+// FindAll returns the timestamps in b, in the formats Parse and ParseWeek accept,
+// in order of their position.
+//
+// A timestamp must not be preceded or followed by a letter or a digit, or by a number
+// and one of ".", "-", "+", ":" and "/", so that version numbers and addresses that
+// contain one do not match. Ordinal dates are not found, since they are
+// indistinguishable from many identifiers, such as "2006-123".
+func FindAll(b []byte) []Match {
+	return defaultParser.FindAll(b)
+}
+
+// FindAllSeq is like FindAll but returns an iterator over the timestamps.
+func FindAllSeq(b []byte) iter.Seq[Match] {
+	return defaultParser.FindAllSeq(b)
+}
+
+// FindAll is like the package function FindAll, in the formats p accepts.
+func (p *Parser) FindAll(b []byte) []Match {
+	return slices.Collect(p.FindAllSeq(b))
+}
+
+// FindAllSeq is like the package function FindAllSeq, in the formats p accepts.
+func (p *Parser) FindAllSeq(b []byte) iter.Seq[Match] {
+	return func(yield func(Match) bool) {
+		for i := 0; i < len(b); i++ {
+			week, ok := p.candidate(b, i)
+			if !ok {
+				continue
+			}
+
+			var d details
+			t, n, err := p.parsePrefix(b[i:], week, &d)
+			if err != nil || !findBoundary(b, i+n, i+n+1) {
+				continue
+			}
+
+			m := Match{Start: i, End: i + n, Time: t, Format: MatchWeek}
+			if !week {
+				switch {
+				case d.prec <= PrecisionDay:
+					m.Format = MatchDate
+				case d.zoned:
+					m.Format = MatchDateTimeZone
+				default:
+					m.Format = MatchDateTime
+				}
+			}
+			if !yield(m) {
+				return
+			}
+			i += n - 1
+		}
+	}
+}
+
+// candidate reports whether a timestamp may start in b at i, and whether it is a week date.
+func (p *Parser) candidate(b []byte, i int) (week bool, ok bool) {
+	if i > 0 && !findBoundary(b, i-1, i-2) {
+		return false, false
+	}
+
+	s := b[i:]
+	if p.YearDigits > 0 && len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		return false, len(s) > 5 && !nd(s[1])
+	}
+	if len(s) < 7 || nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
+		return false, false
+	}
+
+	switch {
+	case s[4] == 'W' || s[4] == '-' && s[5] == 'W':
+		return true, true
+	case p.dateSeparator(s[4]):
+		return false, !nd(s[5])
+	case p.BasicFormat:
+		return false, !nd(s[4])
+	}
+	return false, false
+}
+
+// findBoundary reports whether a timestamp may be next to b[i], given b[j] beyond it.
+func findBoundary(b []byte, i int, j int) bool {
+	if i < 0 || i >= len(b) {
+		return true
+	}
+
+	c := b[i]
+	switch {
+	case isAlnum(c):
+		return false
+	case c == '.' || c == '-' || c == '+' || c == ':' || c == '/':
+		return j < 0 || j >= len(b) || nd(b[j])
+	}
+	return true
+}
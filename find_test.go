@@ -0,0 +1,84 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFindAll(t *testing.T) {
+	type match struct {
+		text   string
+		expect time.Time
+		format MatchFormat
+	}
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect []match
+	}{
+		{Parser{}, "2006-01-02T15:04:05.123Z ERROR failed\n\tat 2006-01-02 15:04:06,5 (retry)", []match{
+			{"2006-01-02T15:04:05.123Z", time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC), MatchDateTimeZone},
+			{"2006-01-02 15:04:06,5", time.Date(2006, 1, 2, 15, 4, 6, 500000000, time.UTC), MatchDateTime},
+		}},
+		{Parser{}, "Released on 2006-01-02. Next week is 2006-W02-1, then 2006W031.", []match{
+			{"2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), MatchDate},
+			{"2006-W02-1", time.Date(2006, 1, 9, 0, 0, 0, 0, time.UTC), MatchWeek},
+			{"2006W031", time.Date(2006, 1, 16, 0, 0, 0, 0, time.UTC), MatchWeek},
+		}},
+		{Parser{}, "backup-2006-01-02.tar.gz (2006-01-03T10:00:00+08:00)", []match{
+			{"2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), MatchDate},
+			{"2006-01-03T10:00:00+08:00", time.Date(2006, 1, 3, 2, 0, 0, 0, time.UTC), MatchDateTimeZone},
+		}},
+		{Parser{Abbreviations: true}, "meeting at 2006-01-02 15:04:05 PST, moved", []match{
+			{"2006-01-02 15:04:05 PST", time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC), MatchDateTimeZone},
+		}},
+		{Parser{ReducedPrecision: true}, "in 2006-01, 2006-02-03T04", []match{
+			{"2006-01", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), MatchDate},
+			{"2006-02-03T04", time.Date(2006, 2, 3, 4, 0, 0, 0, time.UTC), MatchDateTime},
+		}},
+		{Parser{YearDigits: 6}, "from -0044-03-15 to +012345-01-02", []match{
+			{"-0044-03-15", time.Date(-44, 3, 15, 0, 0, 0, 0, time.UTC), MatchDate},
+			{"+012345-01-02", time.Date(12345, 1, 2, 0, 0, 0, 0, time.UTC), MatchDate},
+		}},
+
+		// Not timestamps.
+		{Parser{}, "version 1.2006-01-02, build 12006-01-02, v2006-01-02", nil},
+		{Parser{}, "host 192.168.100.200:2006-01-02 and 2006-01-02.5 and 2006-01-02T15:04:05Z7", nil},
+		{Parser{}, "ordinal 2006-002, invalid 2006-13-02 and 2006-02-30, phone 2006-01-0212", nil},
+		{Parser{}, "W2006-W01-1 and 2006-W54-1", nil},
+		{Parser{}, "", nil},
+	}
+
+	for i, tt := range tests {
+		got := tt.p.FindAll([]byte(tt.value))
+		if len(got) != len(tt.expect) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+		for j, m := range got {
+			e := tt.expect[j]
+			if tt.value[m.Start:m.End] != e.text || !e.expect.Equal(m.Time) || m.Format != e.format {
+				t.Fatalf("case %d: got: %s %+v, expect: %+v, value: %s", i, tt.value[m.Start:m.End], m, e, tt.value)
+			}
+		}
+	}
+}
+
+func TestFindAllSeq(t *testing.T) {
+	text := []byte("2006-01-02 and 2006-01-03 and 2006-01-04")
+
+	var got []string
+	for m := range FindAllSeq(text) {
+		got = append(got, string(text[m.Start:m.End]))
+		if len(got) == 2 {
+			break
+		}
+	}
+	if len(got) != 2 || got[0] != "2006-01-02" || got[1] != "2006-01-03" {
+		t.Fatalf("got: %v", got)
+	}
+
+	if all := FindAll(text); len(all) != 3 {
+		t.Fatalf("got: %v", all)
+	}
+}
@@ -0,0 +1,50 @@
+package parsetime
+
+// FractionPolicy is the handling of fractions of seconds with more than nine digits,
+// which time.Time cannot represent.
+type FractionPolicy int
+
+const (
+	// FractionReject rejects fractions with more than nine digits.
+	FractionReject FractionPolicy = iota
+
+	// FractionTruncate drops the digits beyond nanoseconds.
+	FractionTruncate
+
+	// FractionRound rounds to the nearest nanosecond, and to an even nanosecond when
+	// halfway. Rounding up from the last nanosecond of a second carries into the next
+	// second, and so into the next minute, hour or day, as in
+	// "2006-01-02T23:59:59.9999999999Z" becoming "2006-01-03T00:00:00Z". Second 60 is
+	// handled by the LeapSecond policy before the carry, so that LeapSecondClamp still
+	// gives the last nanosecond of second 59, and LeapSecondRoll second 1.
+	FractionRound
+)
+
+// longFraction applies p.Fraction to the nanoseconds nsec followed by the digits rest,
+// returning the nanoseconds, which are 1e9 if rounding carries into the next second,
+// and whether rest is zero. It also rounds attoseconds, for ParseInstant.
+func (p *Parser) longFraction(nsec int, rest []byte) (n int, exact bool, ok bool) {
+	exact = allZero(rest)
+
+	switch p.Fraction {
+	case FractionTruncate:
+		return nsec, exact, true
+	case FractionRound:
+		// Round up above half, and at half if nsec is odd.
+		if rest[0] > '5' || rest[0] == '5' && (!allZero(rest[1:]) || nsec%2 == 1) {
+			nsec++
+		}
+		return nsec, exact, true
+	}
+	return 0, false, false
+}
+
+// allZero reports whether s has only '0' digits.
+func allZero(s []byte) bool {
+	for _, c := range s {
+		if c != '0' {
+			return false
+		}
+	}
+	return true
+}
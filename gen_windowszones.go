@@ -0,0 +1,82 @@
+//go:build ignore
+
+// This program generates windowszones.go from the CLDR windowsZones.xml, which is
+// common/supplemental/windowsZones.xml in a CLDR release, downloaded beforehand from
+// https://github.com/unicode-org/cldr/blob/release-<release>/common/supplemental/windowsZones.xml.
+// The release is recorded in the generated file:
+//
+//	go run gen_windowszones.go -release 47 windowsZones.xml
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+type supplementalData struct {
+	MapTimezones struct {
+		OtherVersion string `xml:"otherVersion,attr"`
+		TypeVersion  string `xml:"typeVersion,attr"`
+		MapZones     []struct {
+			Other     string `xml:"other,attr"`
+			Territory string `xml:"territory,attr"`
+			Type      string `xml:"type,attr"`
+		} `xml:"mapZone"`
+	} `xml:"windowsZones>mapTimezones"`
+}
+
+func main() {
+	log.SetFlags(0)
+	release := flag.String("release", "", "CLDR release of the file, such as 47")
+	flag.Parse()
+	if *release == "" || flag.NArg() != 1 {
+		log.Fatal("usage: go run gen_windowszones.go -release 47 windowsZones.xml")
+	}
+
+	in, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var data supplementalData
+	if err := xml.Unmarshal(in, &data); err != nil {
+		log.Fatal(err)
+	}
+	if data.MapTimezones.TypeVersion == "" || data.MapTimezones.OtherVersion == "" {
+		log.Fatal("no typeVersion or otherVersion, which every CLDR windowsZones.xml has")
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_windowszones.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package parsetime\n\n")
+	fmt.Fprintf(&b, "// windowsZones maps Windows time zone names to IANA time zone names, from the\n")
+	fmt.Fprintf(&b, "// CLDR windowsZones mapping for territory 001, which is the default for each name.\n")
+	fmt.Fprintf(&b, "// The mapping is from CLDR release %s, for tzdata %s and Windows %s.\n", *release, data.MapTimezones.TypeVersion, data.MapTimezones.OtherVersion)
+	fmt.Fprintf(&b, "var windowsZones = map[string]string{\n")
+	n := 0
+	for _, z := range data.MapTimezones.MapZones {
+		if z.Territory != "001" {
+			continue
+		}
+		fmt.Fprintf(&b, "\t%q: %q,\n", z.Other, z.Type)
+		n++
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	if n == 0 {
+		log.Fatal("no zones for territory 001")
+	}
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("windowszones.go", out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
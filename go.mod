@@ -1 +1,3 @@
 module github.com/richardliao/parsetime
+
+go 1.23
@@ -0,0 +1,123 @@
+package parsetime
+
+import (
+	"math"
+	"time"
+)
+
+// Instant is a time with attosecond precision, for fractions of seconds with up to
+// 18 digits, such as the picosecond timestamps of PTP captures.
+type Instant struct {
+	// Time is the time truncated to nanoseconds.
+	Time time.Time
+
+	// Atto is the attoseconds after Time, in the range [0, 999999999].
+	Atto int64
+}
+
+// ParseInstant is like Parse but keeps the digits of the fraction beyond nanoseconds,
+// up to 18 digits, as attoseconds. Longer fractions are handled as Parser.Fraction
+// says, at attoseconds.
+//
+// The time of the Instant is in the time zone of s, at a fixed offset unless s names
+// a location, and in UTC when s has no time zone, so that AppendFormat writes it back.
+func ParseInstant(s string) (Instant, error) {
+	return defaultParser.ParseInstantBytes([]byte(s))
+}
+
+// ParseInstantBytes is like ParseInstant but accepting bytes.
+func ParseInstantBytes(s []byte) (Instant, error) {
+	return defaultParser.ParseInstantBytes(s)
+}
+
+// ParseInstant is like the package function ParseInstant.
+func (p *Parser) ParseInstant(s string) (Instant, error) {
+	return p.ParseInstantBytes([]byte(s))
+}
+
+// ParseInstantBytes is like the package function ParseInstantBytes.
+func (p *Parser) ParseInstantBytes(s []byte) (Instant, error) {
+	d := details{sub: true}
+	t, err := p.parse(s, 0, &d)
+	if err != nil {
+		return Instant{}, err
+	}
+
+	switch {
+	case d.loc != nil:
+	case d.zoned:
+		t = t.In(fixedZone(d.offset))
+	default:
+		t = t.UTC()
+	}
+	return Instant{Time: t, Atto: int64(d.atto)}, nil
+}
+
+// attoFraction is like longFraction, but keeps the digits of rest up to attoseconds
+// in d.atto and applies p.Fraction to the digits beyond them.
+func (p *Parser) attoFraction(nsec int, rest []byte, d *details) (n int, exact bool, ok bool) {
+	exact = allZero(rest)
+	d.atto, _ = atoiFrac(rest)
+	if len(rest) <= 9 {
+		return nsec, exact, true
+	}
+
+	if d.atto, _, ok = p.longFraction(d.atto, rest[9:]); !ok {
+		return 0, false, false
+	}
+	if d.atto == 1e9 {
+		// Rounding carries into the next nanosecond.
+		d.atto = 0
+		nsec++
+	}
+	return nsec, exact, true
+}
+
+// Compare returns -1 if t is before u, 0 if they are the same instant, and +1 if t is after u.
+func (t Instant) Compare(u Instant) int {
+	if c := t.Time.Compare(u.Time); c != 0 {
+		return c
+	}
+	switch {
+	case t.Atto < u.Atto:
+		return -1
+	case t.Atto > u.Atto:
+		return 1
+	}
+	return 0
+}
+
+// Sub returns t-u as a duration d, rounded down to nanoseconds, and the attoseconds
+// atto after it, in the range [0, 999999999]. As with time.Time.Sub, d saturates at the
+// minimum or maximum duration.
+func (t Instant) Sub(u Instant) (d time.Duration, atto int64) {
+	d = t.Time.Sub(u.Time)
+	atto = t.Atto - u.Atto
+	if atto < 0 && d != math.MinInt64 {
+		atto += 1e9
+		d--
+	}
+	return d, max(atto, 0)
+}
+
+// String returns t in the time.RFC3339Nano format with up to 18 digits of fraction,
+// as in "2006-01-02T15:04:05.123456789012+07:00".
+func (t Instant) String() string {
+	return string(t.AppendFormat(make([]byte, 0, 48)))
+}
+
+// AppendFormat is like String but appends the time to b and returns the extended buffer.
+func (t Instant) AppendFormat(b []byte) []byte {
+	b = t.Time.AppendFormat(b, "2006-01-02T15:04:05")
+
+	if nsec := t.Time.Nanosecond(); nsec != 0 || t.Atto != 0 {
+		b = append(b, '.')
+		if t.Atto == 0 {
+			b = appendFrac(b, nsec)
+		} else {
+			b = appendInt(b, nsec, 9)
+			b = appendFrac(b, int(t.Atto))
+		}
+	}
+	return t.Time.AppendFormat(b, "Z07:00")
+}
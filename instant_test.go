@@ -0,0 +1,90 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInstant(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect Instant
+		format string
+		err    bool
+	}{
+		{Parser{}, "2006-01-02T15:04:05Z", Instant{time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 0}, "2006-01-02T15:04:05Z", false},
+		{Parser{}, "2006-01-02T15:04:05.123+07:00", Instant{time.Date(2006, 1, 2, 8, 4, 5, 123000000, time.UTC), 0}, "2006-01-02T15:04:05.123+07:00", false},
+		{Parser{}, "2006-01-02T15:04:05.123456789012Z", Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 12000000}, "2006-01-02T15:04:05.123456789012Z", false},
+		{Parser{}, "2006-01-02T15:04:05.000000000000000001-05:00", Instant{time.Date(2006, 1, 2, 20, 4, 5, 0, time.UTC), 1}, "2006-01-02T15:04:05.000000000000000001-05:00", false},
+		{Parser{}, "2006-01-02T15:04:05.999999999999999999Z", Instant{time.Date(2006, 1, 2, 15, 4, 5, 999999999, time.UTC), 999999999}, "2006-01-02T15:04:05.999999999999999999Z", false},
+		{Parser{}, "2006-01-02T15:04:05.1234567890", Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 0}, "2006-01-02T15:04:05.123456789Z", false},
+		{Parser{}, "2006-01-02 15:04:05.123456789000100", Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 100000}, "2006-01-02T15:04:05.1234567890001Z", false},
+		{Parser{Fraction: FractionTruncate}, "2006-01-02T15:04:05.1234567891234567899Z", Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 123456789}, "2006-01-02T15:04:05.123456789123456789Z", false},
+		{Parser{Fraction: FractionRound}, "2006-01-02T15:04:05.1234567891234567895Z", Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 123456790}, "2006-01-02T15:04:05.12345678912345679Z", false},
+		{Parser{Fraction: FractionRound}, "2006-01-02T23:59:59.9999999999999999999Z", Instant{time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), 0}, "2006-01-03T00:00:00Z", false},
+		{Parser{LeapSecond: LeapSecondClamp}, "2016-12-31T23:59:60.5Z", Instant{time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), 999999999}, "2016-12-31T23:59:59.999999999999999999Z", false},
+		{Parser{LeapSecond: LeapSecondRoll}, "2016-12-31T23:59:60.5000000001Z", Instant{time.Date(2017, 1, 1, 0, 0, 0, 500000000, time.UTC), 100000000}, "2017-01-01T00:00:00.5000000001Z", false},
+		{Parser{Strict: true}, "2006-01-02T15:04:05.123456789012+00:00", Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 12000000}, "2006-01-02T15:04:05.123456789012Z", false},
+		{Parser{Trim: true}, ` "2006-01-02T15:04:05.123456789012Z" `, Instant{time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), 12000000}, "2006-01-02T15:04:05.123456789012Z", false},
+
+		{Parser{}, "2006-01-02T15:04:05.1234567891234567891Z", Instant{}, "", true},
+		{Parser{EndOfDay: true}, "2006-01-02T24:00:00.000000000001Z", Instant{}, "", true},
+		{Parser{}, "2006-01-02T15:04:05.123456789012x", Instant{}, "", true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.ParseInstant(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Time.Equal(got.Time) || tt.expect.Atto != got.Atto {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+		if s := got.String(); s != tt.format {
+			t.Fatalf("case %d: got: %s, expect: %s, value: %s", i, s, tt.format, tt.value)
+		}
+
+		back, err := ParseInstant(got.String())
+		if err != nil || back.Compare(got) != 0 || back.String() != got.String() {
+			t.Fatalf("case %d: got: %+v %v, expect: %+v, value: %s", i, back, err, got, tt.value)
+		}
+	}
+}
+
+func TestInstantCompareSub(t *testing.T) {
+	base := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		t, u    Instant
+		compare int
+		d       time.Duration
+		atto    int64
+	}{
+		{Instant{base, 0}, Instant{base, 0}, 0, 0, 0},
+		{Instant{base, 1}, Instant{base, 0}, 1, 0, 1},
+		{Instant{base, 0}, Instant{base, 1}, -1, -1, 999999999},
+		{Instant{base.Add(time.Second), 5}, Instant{base, 7}, 1, time.Second - 1, 999999998},
+		{Instant{base, 999999999}, Instant{base.Add(1), 0}, -1, -1, 999999999},
+		{Instant{base.In(time.FixedZone("", 3600)), 3}, Instant{base, 3}, 0, 0, 0},
+	}
+
+	for i, tt := range tests {
+		if c := tt.t.Compare(tt.u); c != tt.compare {
+			t.Fatalf("case %d: got: %d, expect: %d", i, c, tt.compare)
+		}
+		if c := tt.u.Compare(tt.t); c != -tt.compare {
+			t.Fatalf("case %d: got: %d, expect: %d", i, c, -tt.compare)
+		}
+		if d, atto := tt.t.Sub(tt.u); d != tt.d || atto != tt.atto {
+			t.Fatalf("case %d: got: %v %d, expect: %v %d", i, d, atto, tt.d, tt.atto)
+		}
+	}
+}
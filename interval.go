@@ -0,0 +1,217 @@
+package parsetime
+
+import (
+	"bytes"
+	"iter"
+	"time"
+)
+
+// Interval is an ISO 8601 time interval, which may repeat.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+
+	// Duration is the duration given in the text, in the start/duration and
+	// duration/end forms. It is zero in the start/end form.
+	Duration Duration
+
+	// Repeat is the number of occurrences of a repeating interval, as in "R5/...".
+	// It is 1 for an interval that does not repeat, and -1 for one that repeats
+	// without bound, as in "R/...".
+	Repeat int
+
+	// fromEnd is set in the duration/end form, whose repetitions precede End.
+	fromEnd bool
+}
+
+// ParseInterval parses an ISO 8601 time interval in any of the forms
+//
+//	2006-01-02T00:00:00Z/2006-01-09T00:00:00Z
+//	2006-01-02/P1W
+//	P1D/2006-01-09
+//
+// optionally preceded by a repetition, as in "R5/2006-01-02T00:00:00Z/PT1H"
+// or "R/2006-01-02/P1D".
+//
+// Times are in any form accepted by Parse, or with reduced precision as in
+// Parser.ReducedPrecision. In the start/end form the end may omit its
+// higher-order components, which are taken from the start, as in
+// "2006-01-02T10:00/12:00" or "2006-01-02/05"; the end also takes the time
+// zone of the start when it has none, so that "2006-01-02T10:00:00+02:00/2006-01-09"
+// ends at midnight +02:00.
+//
+// Start and End are in the time zone offset given in the text, or UTC if none,
+// so that nominal durations are added on that wall clock.
+func ParseInterval(s string) (Interval, error) {
+	return parseInterval([]byte(s))
+}
+
+// ParseIntervalBytes is like ParseInterval but accepting bytes.
+func ParseIntervalBytes(s []byte) (Interval, error) {
+	return parseInterval(s)
+}
+
+func parseInterval(s []byte) (Interval, error) {
+	iv := Interval{Repeat: 1}
+
+	if len(s) > 0 && s[0] == 'R' {
+		i := bytes.IndexByte(s, '/')
+		if i < 0 {
+			return Interval{}, errParse
+		}
+
+		switch r := s[1:i]; {
+		case len(r) == 0 || string(r) == "-1":
+			iv.Repeat = -1
+		default:
+			n, nLen := atoiDuration(r)
+			if nLen == 0 || nLen != len(r) {
+				return Interval{}, errParse
+			}
+			iv.Repeat = n
+		}
+		s = s[i+1:]
+	}
+
+	i := bytes.IndexByte(s, '/')
+	if i < 0 {
+		return Interval{}, errParse
+	}
+	first, second := s[:i], s[i+1:]
+
+	var err error
+	switch {
+	case len(first) > 0 && first[0] == 'P':
+		if iv.Duration, err = parseDuration(first); err != nil {
+			return Interval{}, err
+		}
+		if iv.End, err = parseEndpoint(second); err != nil {
+			return Interval{}, err
+		}
+		iv.Start = iv.Duration.negate().AddTo(iv.End)
+		iv.fromEnd = true
+	case len(second) > 0 && second[0] == 'P':
+		if iv.Start, err = parseEndpoint(first); err != nil {
+			return Interval{}, err
+		}
+		if iv.Duration, err = parseDuration(second); err != nil {
+			return Interval{}, err
+		}
+		iv.End = iv.Duration.AddTo(iv.Start)
+	default:
+		if iv.Start, err = parseEndpoint(first); err != nil {
+			return Interval{}, err
+		}
+
+		var buf [64]byte
+		if iv.End, err = parseEndpoint(completeEnd(buf[:0], first, second)); err != nil {
+			return Interval{}, err
+		}
+	}
+
+	if iv.End.Before(iv.Start) || iv.Duration.Negative {
+		return Interval{}, errParse
+	}
+
+	return iv, nil
+}
+
+// parseEndpoint parses an interval endpoint, in the time zone offset given in the text.
+func parseEndpoint(s []byte) (time.Time, error) {
+	t, err := reducedParser.parse(s, 0, &details{})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	i := zoneIndex(s)
+	if i == len(s) {
+		return t.UTC(), nil
+	}
+
+	wall, err := reducedParser.parse(s[:i], 0, &details{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(fixedZone(int(wall.Unix() - t.Unix()))), nil
+}
+
+// completeEnd appends to b the end of a start/end interval, taking the components
+// omitted from end from start, and the time zone of start if end has none.
+func completeEnd(b []byte, start, end []byte) []byte {
+	switch {
+	case len(end) > 4 && end[4] == '-' || len(start) < 10:
+		// A complete end starts with the year.
+	case len(end) > 2 && end[2] == ':':
+		// Time of day only.
+		if len(start) < 11 {
+			return end
+		}
+		b = append(b, start[:11]...)
+	case len(end) > 2 && end[2] == '-':
+		// Month and day.
+		b = append(b, start[:5]...)
+	default:
+		// Day.
+		b = append(b, start[:8]...)
+	}
+	b = append(b, end...)
+
+	// The end takes the time zone of the start, at midnight if it is a date alone.
+	if i := zoneIndex(start); i < len(start) && zoneIndex(b) == len(b) {
+		switch len(b) {
+		case 7:
+			b = append(b, "-01"...)
+			fallthrough
+		case 10:
+			b = append(b, "T00:00:00"...)
+		}
+		b = append(b, start[i:]...)
+	}
+	return b
+}
+
+// zoneIndex returns the index of the time zone of a time accepted by parse,
+// or len(s) if it has none.
+func zoneIndex(s []byte) int {
+	for i := 11; i < len(s); i++ {
+		switch s[i] {
+		case '+', '-', 'Z', 'z':
+			return i
+		}
+	}
+	return len(s)
+}
+
+// Repetitions returns an iterator over the occurrences of iv, starting with iv itself.
+// A repeating interval in the duration/end form repeats backwards, so its
+// occurrences are yielded latest first.
+//
+// Occurrences are found by adding multiples of the duration to the first start,
+// so that, for example, monthly repetitions from January 31 fall on the last day
+// of each month rather than drifting.
+func (iv Interval) Repetitions() iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		step := iv.End.Sub(iv.Start)
+
+		for k := 0; iv.Repeat < 0 || k < iv.Repeat; k++ {
+			r := iv
+			r.Repeat = 1
+
+			switch {
+			case iv.Duration == Duration{}:
+				r.Start = iv.Start.Add(time.Duration(k) * step)
+				r.End = iv.End.Add(time.Duration(k) * step)
+			case iv.fromEnd:
+				r.End = iv.Duration.mul(-k).AddTo(iv.End)
+				r.Start = iv.Duration.mul(-k - 1).AddTo(iv.End)
+			default:
+				r.Start = iv.Duration.mul(k).AddTo(iv.Start)
+				r.End = iv.Duration.mul(k + 1).AddTo(iv.Start)
+			}
+
+			if !yield(r) {
+				return
+			}
+		}
+	}
+}
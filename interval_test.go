@@ -0,0 +1,136 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	plus2 := time.FixedZone("", 2*3600)
+
+	tests := []struct {
+		value  string
+		start  time.Time
+		end    time.Time
+		repeat int
+		err    bool
+	}{
+		{"2006-01-02T00:00:00Z/2006-01-09T00:00:00Z", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 9, 0, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02/P1W", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 9, 0, 0, 0, 0, time.UTC), 1, false},
+		{"P1D/2006-01-09", time.Date(2006, 1, 8, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 9, 0, 0, 0, 0, time.UTC), 1, false},
+		{"R5/2006-01-02T00:00:00Z/PT1H", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 2, 1, 0, 0, 0, time.UTC), 5, false},
+		{"R/2006-01-02/P1D", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), -1, false},
+		{"R-1/2006-01-02/P1D", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), -1, false},
+		{"2006-01-02T10:00:00/12:00:00", time.Date(2006, 1, 2, 10, 0, 0, 0, time.UTC), time.Date(2006, 1, 2, 12, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02T10:00:00+02:00/12:00:00", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 2, 12, 0, 0, 0, plus2), 1, false},
+		{"2006-01-02T10:00:00+02:00/12:00:00Z", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 2, 12, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02T10:00:00+02:00/2006-01-02T12:00:00", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 2, 12, 0, 0, 0, plus2), 1, false},
+		{"2006-01-02T10:00:00+02:00/2006-01-02T12:00:00Z", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 2, 12, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02T10:00/12:00", time.Date(2006, 1, 2, 10, 0, 0, 0, time.UTC), time.Date(2006, 1, 2, 12, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01/2006-03", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2006, 3, 1, 0, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02T10:00:00/05T12:00:00", time.Date(2006, 1, 2, 10, 0, 0, 0, time.UTC), time.Date(2006, 1, 5, 12, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02/02-05", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 2, 5, 0, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02/05", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 5, 0, 0, 0, 0, time.UTC), 1, false},
+		{"2006-01-02T10:00:00+02:00/2006-01-09", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 9, 0, 0, 0, 0, plus2), 1, false},
+		{"2006-01-02T10:00:00+02:00/09", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 9, 0, 0, 0, 0, plus2), 1, false},
+		{"2006-01-02T10:00:00+02:00/2006-02", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 2, 1, 0, 0, 0, 0, plus2), 1, false},
+		{"2006-01-02T10:00:00+02:00/2006-01-02T12:00:00-05:00", time.Date(2006, 1, 2, 10, 0, 0, 0, plus2), time.Date(2006, 1, 2, 12, 0, 0, 0, time.FixedZone("", -5*3600)), 1, false},
+		{"2006-01-31T23:00:00-05:00/P1M", time.Date(2006, 1, 31, 23, 0, 0, 0, time.FixedZone("", -5*3600)), time.Date(2006, 2, 28, 23, 0, 0, 0, time.FixedZone("", -5*3600)), 1, false},
+
+		{"2006-01-02", time.Time{}, time.Time{}, 0, true},
+		{"2006-01-09/2006-01-02", time.Time{}, time.Time{}, 0, true},
+		{"P1D/P1D", time.Time{}, time.Time{}, 0, true},
+		{"2006-01-02/-P1D", time.Time{}, time.Time{}, 0, true},
+		{"2006-01-02/P1X", time.Time{}, time.Time{}, 0, true},
+		{"2006-01-02/2006-13-01", time.Time{}, time.Time{}, 0, true},
+		{"Rx/2006-01-02/P1D", time.Time{}, time.Time{}, 0, true},
+		{"R5", time.Time{}, time.Time{}, 0, true},
+		{"R5/2006-01-02", time.Time{}, time.Time{}, 0, true},
+		{"2006-01-02T10:00:00/12:60:00", time.Time{}, time.Time{}, 0, true},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseInterval(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) || got.Repeat != tt.repeat {
+			t.Fatalf("case %d: got: %s/%s R%d, expect: %s/%s R%d, value: %s", i, got.Start, got.End, got.Repeat, tt.start, tt.end, tt.repeat, tt.value)
+		}
+
+		_, offset := got.Start.Zone()
+		_, expectOffset := tt.start.Zone()
+		_, endOffset := got.End.Zone()
+		_, expectEndOffset := tt.end.Zone()
+		if offset != expectOffset || endOffset != expectEndOffset {
+			t.Fatalf("case %d: got offsets: %d/%d, expect: %d/%d, value: %s", i, offset, endOffset, expectOffset, expectEndOffset, tt.value)
+		}
+	}
+}
+
+func TestIntervalRepetitions(t *testing.T) {
+	tests := []struct {
+		value  string
+		limit  int
+		expect []time.Time
+	}{
+		{"R3/2006-01-02T00:00:00Z/PT1H", 10, []time.Time{
+			time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 1, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 2, 0, 0, 0, time.UTC),
+		}},
+		{"R/2006-01-31/P1M", 4, []time.Time{
+			time.Date(2006, 1, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 2, 28, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 3, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 4, 30, 0, 0, 0, 0, time.UTC),
+		}},
+		{"R2/2006-01-02T00:00:00Z/2006-01-02T00:30:00Z", 10, []time.Time{
+			time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 0, 30, 0, 0, time.UTC),
+		}},
+		{"R3/P1D/2006-01-09", 10, []time.Time{
+			time.Date(2006, 1, 8, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 7, 0, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 6, 0, 0, 0, 0, time.UTC),
+		}},
+		{"2006-01-02/P1D", 10, []time.Time{
+			time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		}},
+		{"R0/2006-01-02/P1D", 10, nil},
+	}
+
+	for i, tt := range tests {
+		iv, err := ParseInterval(tt.value)
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		var got []time.Time
+		for r := range iv.Repetitions() {
+			if r.End.Sub(r.Start) <= 0 {
+				t.Fatalf("case %d: empty occurrence %s/%s", i, r.Start, r.End)
+			}
+			got = append(got, r.Start)
+			if len(got) == tt.limit {
+				break
+			}
+		}
+
+		if len(got) != len(tt.expect) {
+			t.Fatalf("case %d: got %d occurrences, expect %d", i, len(got), len(tt.expect))
+		}
+		for j := range got {
+			if !got[j].Equal(tt.expect[j]) {
+				t.Fatalf("case %d: occurrence %d got: %s, expect: %s", i, j, got[j], tt.expect[j])
+			}
+		}
+	}
+}
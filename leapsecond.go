@@ -0,0 +1,75 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// LeapSecondPolicy is the handling of second 60, as in "2016-12-31T23:59:60Z".
+type LeapSecondPolicy int
+
+const (
+	// LeapSecondReject rejects second 60.
+	LeapSecondReject LeapSecondPolicy = iota
+
+	// LeapSecondClamp maps second 60 to the last nanosecond of second 59,
+	// so that the result still sorts before the next minute.
+	LeapSecondClamp
+
+	// LeapSecondRoll maps second 60 to second 0 of the next minute, keeping the fraction.
+	LeapSecondRoll
+)
+
+// leapSecond returns the time of second 60, given the unix time of second 59 in UTC.
+// The nanoseconds are 1e9 if rounding the fraction carries past second 60.
+func (p *Parser) leapSecond(unix int64, nsec int) (time.Time, error) {
+	if p.LeapSecondTable && !isLeapSecond(unix+1) {
+		return time.Time{}, errParse
+	}
+
+	if p.LeapSecond == LeapSecondClamp {
+		return time.Unix(unix, 999999999), nil
+	}
+	return time.Unix(unix+1, int64(nsec)), nil
+}
+
+// isLeapSecond reports whether a leap second was inserted just before the unix time.
+func isLeapSecond(unix int64) bool {
+	for _, t := range leapSeconds {
+		if t == unix {
+			return true
+		}
+	}
+	return false
+}
+
+// leapSeconds is the unix time following each leap second, which were all inserted at the
+// end of the UTC day, as published by the IERS. No leap second has been inserted since 2016.
+var leapSeconds = [...]int64{
+	78796800,   // 1972-06-30
+	94694400,   // 1972-12-31
+	126230400,  // 1973-12-31
+	157766400,  // 1974-12-31
+	189302400,  // 1975-12-31
+	220924800,  // 1976-12-31
+	252460800,  // 1977-12-31
+	283996800,  // 1978-12-31
+	315532800,  // 1979-12-31
+	362793600,  // 1981-06-30
+	394329600,  // 1982-06-30
+	425865600,  // 1983-06-30
+	489024000,  // 1985-06-30
+	567993600,  // 1987-12-31
+	631152000,  // 1989-12-31
+	662688000,  // 1990-12-31
+	709948800,  // 1992-06-30
+	741484800,  // 1993-06-30
+	773020800,  // 1994-06-30
+	820454400,  // 1995-12-31
+	867715200,  // 1997-06-30
+	915148800,  // 1998-12-31
+	1136073600, // 2005-12-31
+	1230768000, // 2008-12-31
+	1341100800, // 2012-06-30
+	1435708800, // 2015-06-30
+	1483228800, // 2016-12-31
+}
@@ -0,0 +1,66 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// ParseOrdinal parses an ISO 8601 ordinal date, that is a year and day of year,
+// in the extended ("2006-002") or basic ("2006002") format, optionally followed
+// by a time of day and time zone in any form accepted by Parse, as in
+// "2006-002T15:04:05Z".
+//
+// Day 366 is only accepted in leap years.
+//
+// In the absence of time zone information, ParseOrdinal interprets the time as in UTC.
+func ParseOrdinal(s string) (time.Time, error) {
+	return parseOrdinal([]byte(s), 0)
+}
+
+// ParseOrdinalBytes is like ParseOrdinal but accepting bytes.
+func ParseOrdinalBytes(s []byte) (time.Time, error) {
+	return parseOrdinal(s, 0)
+}
+
+// FormatOrdinal returns the ISO 8601 extended ordinal date of t, such as "2006-002".
+func FormatOrdinal(t time.Time) string {
+	return string(AppendOrdinal(make([]byte, 0, 8), t))
+}
+
+// AppendOrdinal is like FormatOrdinal but appends the ordinal date to b and returns the extended buffer.
+func AppendOrdinal(b []byte, t time.Time) []byte {
+	b = appendInt(b, t.Year(), 4)
+	b = append(b, '-')
+	return appendInt(b, t.YearDay(), 3)
+}
+
+func parseOrdinal(s []byte, locOffset int) (time.Time, error) {
+	sLen := len(s)
+
+	if sLen < 7 || nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
+		return time.Time{}, errParse
+	}
+	year := int(s[0]-'0')*1e3 + int(s[1]-'0')*1e2 + int(s[2]-'0')*1e1 + int(s[3]-'0')
+
+	i := 4
+	if s[i] == '-' {
+		i++
+	}
+
+	if i+3 > sLen || nd(s[i]) || nd(s[i+1]) || nd(s[i+2]) {
+		return time.Time{}, errParse
+	}
+	day := int(s[i]-'0')*1e2 + int(s[i+1]-'0')*1e1 + int(s[i+2]-'0')
+	i += 3
+
+	daysIn := 365
+	if isLeap(year) {
+		daysIn = 366
+	}
+	if day < 1 || day > daysIn {
+		return time.Time{}, errParse
+	}
+
+	daysEpoc := daysSinceEpoch(year) + uint64(day-1)
+
+	return defaultParser.parseClock(s[i:], daysEpoc, locOffset, &details{})
+}
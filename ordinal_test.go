@@ -0,0 +1,69 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseOrdinal(t *testing.T) {
+	tests := []struct {
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{"2006-002", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{"2006002", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{"2026-289", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), false},
+		{"2026-365", time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), false},
+		{"2024-366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), false},
+		{"2000-060", time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"2026-289T12:00:00Z", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), false},
+		{"2026289T12:00:00.5-02:00", time.Date(2026, 10, 16, 14, 0, 0, 500000000, time.UTC), false},
+		{"2026-289 12:00:00", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), false},
+
+		{"2026-366", time.Time{}, true},
+		{"1900-366", time.Time{}, true},
+		{"2026-000", time.Time{}, true},
+		{"2026-28", time.Time{}, true},
+		{"2026-2899", time.Time{}, true},
+		{"2026-28a", time.Time{}, true},
+		{"2026-289T12:00", time.Time{}, true},
+		{"2026-289X12:00:00", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseOrdinal(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	start := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day := start; day.Year() < 2040; day = day.AddDate(0, 0, 1) {
+		s := FormatOrdinal(day)
+
+		got, err := ParseOrdinal(s)
+		if err != nil {
+			t.Fatalf("%s: got error: %s, value: %s", day, err, s)
+		}
+		if !got.Equal(day) {
+			t.Fatalf("%s: got: %+v, value: %s", day, got, s)
+		}
+	}
+
+	if s := FormatOrdinal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)); s != "2006-002" {
+		t.Fatalf("got: %s, expect: 2006-002", s)
+	}
+}
@@ -0,0 +1,245 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Parser parses times like Parse, with optional extensions to the accepted formats.
+//
+// The zero Parser accepts the same formats as Parse.
+// A Parser must not be modified while in use, and is safe for concurrent use otherwise.
+type Parser struct {
+	// Trim ignores surrounding whitespace, and matching quotes or brackets around the
+	// rest, as in `"2006-01-02T15:04:05Z"`, "[2006-01-02 15:04:05,123]" or
+	// "2006-01-02 15:04:05\r\n", without allocating.
+	Trim bool
+
+	// Unicode accepts full-width digits and punctuation, as in "２０２６－１０－１６",
+	// Arabic-Indic and Persian digits, the minus sign U+2212 in offsets, Unicode
+	// hyphens and non-breaking spaces, and ignores bidirectional marks. Texts of only
+	// ASCII are parsed without allocating.
+	Unicode bool
+
+	// Strict only accepts strict RFC 3339 date-times, like ParseStrict, and returns a
+	// *StrictError for other texts. Of the other options, only Trim, Unicode, LeapSecond,
+	// LeapSecondTable, Fraction and the accepted window apply. Second 60 is only accepted
+	// with a LeapSecond policy, and fractions beyond nanoseconds are truncated unless rounded.
+	Strict bool
+
+	// YearDigits enables signed years, as in "+012345-01-02" or "-0044-03-15",
+	// with 4 to YearDigits digits after the sign.
+	//
+	// Years use astronomical numbering, so year 0 is 1 BC and year -44 is 45 BC.
+	// Years that time.Time cannot represent return ErrRange.
+	YearDigits int
+
+	// DateSeparators lists the separators accepted between the year, month and day
+	// besides "-", as in "/." for "2006/01/02" and "2006.01.02". Both separators of
+	// a date must be the same.
+	DateSeparators string
+
+	// TimeSeparators lists the separators accepted between the hour, minute and second
+	// besides ":", as in "-" for "2006-01-02_15-04-05". Both separators of a time must
+	// be the same.
+	TimeSeparators string
+
+	// DateTimeSeparators lists the separators accepted between the date and the time
+	// besides "T" and " ", as in "t_".
+	DateTimeSeparators string
+
+	// BasicFormat accepts dates and times without separators, as in "20060102",
+	// "20060102T150405Z" and "20060102150405".
+	BasicFormat bool
+
+	// LeapSecond is the handling of second 60, which is rejected by default.
+	LeapSecond LeapSecondPolicy
+
+	// LeapSecondTable only accepts second 60 at the leap seconds in the embedded table,
+	// taking the time zone offset into account. Otherwise it is accepted in any minute.
+	LeapSecondTable bool
+
+	// Fraction is the handling of fractions of seconds with more than nine digits,
+	// which are rejected by default.
+	Fraction FractionPolicy
+
+	// EndOfDay accepts hour 24, as in "2006-01-02T24:00:00", meaning the end of the day,
+	// which is midnight of the next day. The minute, second and fraction must be zero.
+	EndOfDay bool
+
+	// ReducedPrecision accepts times with trailing components omitted: a year ("2006"),
+	// a month ("2006-01"), an hour ("2006-01-02T15") or a minute ("2006-01-02T15:04"),
+	// optionally followed by a time zone. The omitted components are the first of
+	// their period, and ParseResult reports the precision present in the text.
+	ReducedPrecision bool
+
+	// LenientOffsets accepts time zone offsets with one-digit hours, as in "+8" or
+	// "+8:00", hours up to 23 of either sign, seconds, as in "+05:53:28", and "UTC",
+	// "GMT" or "UT" alone or before an offset, as in "UTC+8" or "GMT-05:00", optionally
+	// after a space. Otherwise offsets follow RFC 3339, with hours up to 14 east and
+	// 12 west of UTC.
+	LenientOffsets bool
+
+	// Abbreviations accepts a time zone abbreviation, such as "PST" or "CEST", following
+	// the time, optionally after a space, as in "2006-01-02 15:04:05 PST", or a military
+	// time zone letter, such as "A" or "N", directly following it, as in "15:04:05A".
+	//
+	// Ambiguous abbreviations take their most common meaning, which is US Central
+	// Time for "CST" and India Standard Time for "IST".
+	Abbreviations bool
+
+	// AbbreviationOffsets adds abbreviations, or overrides the meaning of built-in
+	// ones, mapping them to offsets in seconds east of UTC, as in {"CST": 8 * 3600}.
+	AbbreviationOffsets map[string]int
+
+	// StrictAbbreviations rejects ambiguous abbreviations that are not in
+	// AbbreviationOffsets, rather than taking their most common meaning.
+	StrictAbbreviations bool
+
+	// Annotations accepts an RFC 9557 suffix following the time zone, as in
+	// "2006-01-02T15:04:05+01:00[Europe/Paris][u-ca=gregory]", and returns times in the
+	// location it names. Without an offset, as in "2006-01-02T15:04:05[Europe/Paris]",
+	// the time is the wall time in that location.
+	//
+	// An offset inconsistent with the location is rejected when the location is marked
+	// critical, as in "[!Europe/Paris]", and takes precedence otherwise. Unknown tags are
+	// rejected when critical and ignored otherwise. Locations are loaded with
+	// time.LoadLocation and cached.
+	Annotations bool
+
+	// ZoneNames accepts an IANA time zone name following the time after a space, as in
+	// "2006-01-02 15:04:05 America/New_York", meaning the wall time in that location,
+	// and returns times in that location. Locations are loaded with time.LoadLocation
+	// and cached. A wall time skipped or repeated by a transition is resolved like
+	// time.Date does.
+	ZoneNames bool
+
+	// WindowsZones accepts a Windows time zone name following the time after a space,
+	// as in "2006-01-02 15:04:05 China Standard Time", like ZoneNames does for IANA
+	// names, mapping it to an IANA location with WindowsZone.
+	//
+	// Only the mapping is embedded. Like ZoneNames and Annotations, the locations come
+	// from time.LoadLocation, so on hosts without a time zone database, such as Windows
+	// or minimal containers, the program must import time/tzdata, or every name is rejected.
+	WindowsZones bool
+
+	// NotBefore and NotAfter, unless zero, are the earliest and latest accepted times.
+	// Well-formed times outside them return ErrOutOfWindow, as do those outside the
+	// window of MaxPast and MaxFuture.
+	NotBefore, NotAfter time.Time
+
+	// MaxPast and MaxFuture, unless zero, are how far before and after the current time
+	// a time is accepted, as in a MaxFuture of 24 * time.Hour to reject times more than
+	// a day ahead.
+	MaxPast, MaxFuture time.Duration
+
+	// Clock returns the current time for MaxPast and MaxFuture. It is time.Now if nil.
+	Clock func() time.Time
+}
+
+// Parse is like the package function Parse.
+func (p *Parser) Parse(s string) (time.Time, error) {
+	return p.parse([]byte(s), 0, &details{})
+}
+
+// ParseBytes is like the package function ParseBytes.
+func (p *Parser) ParseBytes(s []byte) (time.Time, error) {
+	return p.parse(s, 0, &details{})
+}
+
+// ParseInLocation is like the package function ParseInLocation.
+func (p *Parser) ParseInLocation(s string, loc *time.Location, locOffset int) (time.Time, error) {
+	return p.ParseBytesInLocation([]byte(s), loc, locOffset)
+}
+
+// ParseBytesInLocation is like the package function ParseBytesInLocation.
+func (p *Parser) ParseBytesInLocation(s []byte, loc *time.Location, locOffset int) (time.Time, error) {
+	t, err := p.parse(s, locOffset, &details{})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.In(loc), nil
+}
+
+// atoiYear parses the signed year at the start of s, with 4 to p.YearDigits digits,
+// returning the year and its length including the sign, or 0 if there is none.
+func (p *Parser) atoiYear(s []byte) (year int, n int) {
+	for n = 1; n < len(s) && !nd(s[n]); n++ {
+		if n > p.YearDigits {
+			return 0, 0
+		}
+		if year <= maxYear {
+			year = year*10 + int(s[n]-'0')
+		}
+	}
+
+	// ISO 8601 has no negative zero year.
+	if n < 5 || year == 0 && s[0] == '-' {
+		return 0, 0
+	}
+
+	if s[0] == '-' {
+		year = -year
+	}
+	return year, n
+}
+
+// parseReducedDate parses a reduced precision year, or month if month is set, as the
+// first day of the period. The year may be signed.
+func (p *Parser) parseReducedDate(s []byte, month bool, locOffset int, d *details) (time.Time, error) {
+	var buf [32]byte
+	b := append(buf[:0], s...)
+	if month {
+		b = append(b, '-', '0', '1')
+	} else {
+		b = append(b, '-', '0', '1', '-', '0', '1')
+	}
+
+	t, err := p.parse(b, locOffset, d)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	d.prec = PrecisionYear
+	if month {
+		d.prec = PrecisionMonth
+	}
+	return t, nil
+}
+
+// parseReducedClock parses a reduced precision hour or minute, following the
+// separator in s, as the first second of the period.
+func (p *Parser) parseReducedClock(s []byte, daysEpoc uint64, locOffset int, d *details) (time.Time, error) {
+	prec := PrecisionHour
+	i := 3
+	if len(s) >= 6 && s[3] == ':' {
+		prec = PrecisionMinute
+		i = 6
+	}
+
+	// Only a time zone may follow, which is an offset, or any zone p accepts by name.
+	if i < len(s) {
+		switch c := s[i]; {
+		case c == '+' || c == '-' || c == 'z' || c == 'Z':
+		case p.namedZones() && nd(c) && c != ':' && c != '.' && c != ',':
+		default:
+			return time.Time{}, errParse
+		}
+	}
+
+	var buf [64]byte
+	b := append(buf[:0], s[:i]...)
+	if prec == PrecisionHour {
+		b = append(b, ':', '0', '0')
+	}
+	b = append(b, ':', '0', '0')
+	b = append(b, s[i:]...)
+
+	t, err := p.parseClock(b, daysEpoc, locOffset, d)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	d.prec = prec
+	return t, nil
+}
@@ -0,0 +1,512 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParserYearDigits(t *testing.T) {
+	tests := []struct {
+		digits int
+		value  string
+		expect time.Time
+		err    error
+	}{
+		{6, "+012345-01-02", time.Date(12345, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+		{6, "-0044-03-15", time.Date(-44, 3, 15, 0, 0, 0, 0, time.UTC), nil},
+		{6, "+275760-09-13T00:00:00Z", time.Date(275760, 9, 13, 0, 0, 0, 0, time.UTC), nil},
+		{6, "-271821-04-20T00:00:00.5+01:00", time.Date(-271821, 4, 19, 23, 0, 0, 500000000, time.UTC), nil},
+		{6, "+0000-01-01", time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{6, "-0004-02-29", time.Date(-4, 2, 29, 0, 0, 0, 0, time.UTC), nil},
+		{6, "+2006-01-02 15:04:05", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), nil},
+		{6, "2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+		{12, "+292277026595-12-31T23:59:59Z", time.Date(292277026595, 12, 31, 23, 59, 59, 0, time.UTC), nil},
+		{12, "-292277022398-01-01T00:00:00Z", time.Date(-292277022398, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+
+		{0, "+2006-01-02", time.Time{}, errParse},
+		{6, "-0000-01-01", time.Time{}, errParse},
+		{6, "+1234567-01-02", time.Time{}, errParse},
+		{6, "+123-01-02", time.Time{}, errParse},
+		{6, "012345-01-02", time.Time{}, errParse},
+		{6, "-0001-02-29", time.Time{}, errParse},
+		{6, "+012345-13-02", time.Time{}, errParse},
+		{6, "+", time.Time{}, errParse},
+		{6, "+012345", time.Time{}, errParse},
+		{12, "+292277026596-01-01", time.Time{}, ErrRange},
+		{12, "-292277022399-01-01", time.Time{}, ErrRange},
+		{20, "+99999999999999999999-01-01", time.Time{}, ErrRange},
+	}
+
+	for i, tt := range tests {
+		p := &Parser{YearDigits: tt.digits}
+
+		got, err := p.Parse(tt.value)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("case %d: got error: %v, expect: %v, value: %s", i, err, tt.err, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserLeapSecond(t *testing.T) {
+	tests := []struct {
+		policy LeapSecondPolicy
+		table  bool
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{LeapSecondClamp, false, "2016-12-31T23:59:60Z", time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), false},
+		{LeapSecondClamp, false, "2016-12-31T23:59:60.5Z", time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), false},
+		{LeapSecondRoll, false, "2016-12-31T23:59:60Z", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{LeapSecondRoll, false, "2016-12-31T23:59:60.5Z", time.Date(2017, 1, 1, 0, 0, 0, 500000000, time.UTC), false},
+		{LeapSecondRoll, false, "2016-12-31 23:59:60", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{LeapSecondRoll, false, "2026-10-16T12:30:60Z", time.Date(2026, 10, 16, 12, 31, 0, 0, time.UTC), false},
+		{LeapSecondRoll, true, "2016-12-31T23:59:60Z", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{LeapSecondRoll, true, "2016-12-31T18:59:60-05:00", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{LeapSecondClamp, true, "1972-07-01T05:29:60.123+05:30", time.Date(1972, 6, 30, 23, 59, 59, 999999999, time.UTC), false},
+		{LeapSecondReject, false, "2016-12-31T23:59:59Z", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), false},
+
+		{LeapSecondReject, false, "2016-12-31T23:59:60Z", time.Time{}, true},
+		{LeapSecondReject, true, "2016-12-31T23:59:60Z", time.Time{}, true},
+		{LeapSecondRoll, true, "2026-10-16T12:30:60Z", time.Time{}, true},
+		{LeapSecondRoll, true, "2016-12-31T23:59:60+01:00", time.Time{}, true},
+		{LeapSecondRoll, true, "2017-12-31T23:59:60Z", time.Time{}, true},
+		{LeapSecondRoll, false, "2016-12-31T23:59:61Z", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		p := &Parser{LeapSecond: tt.policy, LeapSecondTable: tt.table}
+
+		got, err := p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserEndOfDay(t *testing.T) {
+	tests := []struct {
+		endOfDay bool
+		value    string
+		expect   time.Time
+		err      bool
+	}{
+		{true, "2006-01-02T24:00:00", time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), false},
+		{true, "2006-01-02 24:00:00.000", time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), false},
+		{true, "2006-01-31T24:00:00Z", time.Date(2006, 2, 1, 0, 0, 0, 0, time.UTC), false},
+		{true, "2024-02-28T24:00:00Z", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{true, "2026-12-31T24:00:00+08:00", time.Date(2026, 12, 31, 16, 0, 0, 0, time.UTC), false},
+		{true, "2026-12-31T24:00:00Z", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{true, "2026-12-31T23:00:00Z", time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC), false},
+
+		{false, "2006-01-02T24:00:00", time.Time{}, true},
+		{true, "2006-01-02T24:00:01", time.Time{}, true},
+		{true, "2006-01-02T24:01:00", time.Time{}, true},
+		{true, "2006-01-02T24:00:00.001Z", time.Time{}, true},
+		{true, "2006-01-02T24:00:60Z", time.Time{}, true},
+		{true, "2006-01-02T25:00:00Z", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		p := &Parser{EndOfDay: tt.endOfDay, LeapSecond: LeapSecondRoll}
+
+		got, err := p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserReducedPrecision(t *testing.T) {
+	tests := []struct {
+		reduced bool
+		value   string
+		expect  time.Time
+		prec    Precision
+		err     bool
+	}{
+		{true, "2006", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), PrecisionYear, false},
+		{true, "2006-01", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth, false},
+		{true, "2006-12", time.Date(2006, 12, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth, false},
+		{true, "2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), PrecisionDay, false},
+		{true, "2006-01-02T15", time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC), PrecisionHour, false},
+		{true, "2006-01-02 15", time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC), PrecisionHour, false},
+		{true, "2006-01-02T15Z", time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC), PrecisionHour, false},
+		{true, "2006-01-02T15+08", time.Date(2006, 1, 2, 7, 0, 0, 0, time.UTC), PrecisionHour, false},
+		{true, "2006-01-02T15:04", time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC), PrecisionMinute, false},
+		{true, "2006-01-02T15:04Z", time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC), PrecisionMinute, false},
+		{true, "2006-01-02T15:04-05:00", time.Date(2006, 1, 2, 20, 4, 0, 0, time.UTC), PrecisionMinute, false},
+		{true, "2006-01-02T15:04:05", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), PrecisionSecond, false},
+		{true, "2006-01-02T15:04:05.123Z", time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC), PrecisionMillisecond, false},
+		{true, "2006-01-02T15:04:05.1234567", time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC), PrecisionSecond + 7, false},
+		{false, "2006-01-02T15:04:05.123456789+08:00", time.Date(2006, 1, 2, 7, 4, 5, 123456789, time.UTC), PrecisionNanosecond, false},
+		{false, "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), PrecisionSecond, false},
+		{false, "2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), PrecisionDay, false},
+
+		{false, "2006", time.Time{}, 0, true},
+		{false, "2006-01", time.Time{}, 0, true},
+		{false, "2006-01-02T15", time.Time{}, 0, true},
+		{false, "2006-01-02T15:04", time.Time{}, 0, true},
+		{true, "200", time.Time{}, 0, true},
+		{true, "2006-1", time.Time{}, 0, true},
+		{true, "2006-13", time.Time{}, 0, true},
+		{true, "2006-01-0", time.Time{}, 0, true},
+		{true, "2006-01-02T", time.Time{}, 0, true},
+		{true, "2006-01-02T1", time.Time{}, 0, true},
+		{true, "2006-01-02T24", time.Time{}, 0, true},
+		{true, "2006-01-02T15:0", time.Time{}, 0, true},
+		{true, "2006-01-02T15:60", time.Time{}, 0, true},
+		{true, "2006-01-02T15:04:5", time.Time{}, 0, true},
+		{true, "2006-01-02T15.5", time.Time{}, 0, true},
+		{true, "2006-01-02T15:04x", time.Time{}, 0, true},
+	}
+
+	for i, tt := range tests {
+		p := &Parser{ReducedPrecision: tt.reduced}
+
+		got, err := p.ParseResult(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got.Time) || got.Precision != tt.prec {
+			t.Fatalf("case %d: got: %+v %d, expect: %+v %d, value: %s", i, got.Time, got.Precision, tt.expect, tt.prec, tt.value)
+		}
+	}
+}
+
+func TestParserReducedPrecisionZones(t *testing.T) {
+	signed := Parser{ReducedPrecision: true, YearDigits: 6}
+	paris, _ := time.LoadLocation("Europe/Paris")
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		prec   Precision
+		err    bool
+	}{
+		{signed, "+012345", time.Date(12345, 1, 1, 0, 0, 0, 0, time.UTC), PrecisionYear, false},
+		{signed, "+012345-06", time.Date(12345, 6, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth, false},
+		{signed, "+2006", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), PrecisionYear, false},
+		{signed, "+2006-06", time.Date(2006, 6, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth, false},
+		{signed, "-0044-03", time.Date(-44, 3, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth, false},
+		{signed, "+012345-06-07T08", time.Date(12345, 6, 7, 8, 0, 0, 0, time.UTC), PrecisionHour, false},
+		{signed, "2006", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), PrecisionYear, false},
+		{Parser{ReducedPrecision: true, Annotations: true}, "2006-01-02T15:04[Europe/Paris]", time.Date(2006, 1, 2, 15, 4, 0, 0, paris), PrecisionMinute, false},
+		{Parser{ReducedPrecision: true, Annotations: true}, "2006-01-02T15+01:00[Europe/Paris]", time.Date(2006, 1, 2, 15, 0, 0, 0, paris), PrecisionHour, false},
+		{Parser{ReducedPrecision: true, Abbreviations: true}, "2006-01-02T15:04 PST", time.Date(2006, 1, 2, 23, 4, 0, 0, time.UTC), PrecisionMinute, false},
+		{Parser{ReducedPrecision: true, ZoneNames: true}, "2006-01-02 15:04 Europe/Paris", time.Date(2006, 1, 2, 15, 4, 0, 0, paris), PrecisionMinute, false},
+
+		{signed, "+123", time.Time{}, 0, true},
+		{signed, "+0123456", time.Time{}, 0, true},
+		{signed, "+012345-6", time.Time{}, 0, true},
+		{signed, "+012345-13", time.Time{}, 0, true},
+		{Parser{ReducedPrecision: true}, "2006-01-02T15:04[Europe/Paris]", time.Time{}, 0, true},
+		{Parser{ReducedPrecision: true}, "2006-01-02T15:04 PST", time.Time{}, 0, true},
+		{Parser{ReducedPrecision: true, Abbreviations: true}, "2006-01-02T15:0 PST", time.Time{}, 0, true},
+		{Parser{ReducedPrecision: true, Abbreviations: true}, "2006-01-02T15:04.5 PST", time.Time{}, 0, true},
+		{Parser{ReducedPrecision: true, Abbreviations: true}, "2006-01-02T15:04 XYZ", time.Time{}, 0, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.ParseResult(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got.Time) || got.Precision != tt.prec {
+			t.Fatalf("case %d: got: %+v %d, expect: %+v %d, value: %s", i, got.Time, got.Precision, tt.expect, tt.prec, tt.value)
+		}
+	}
+}
+
+func TestParserAbbreviations(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 PST", time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 UTC", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 GMT", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05.123 CEST", time.Date(2006, 1, 2, 13, 4, 5, 123000000, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05PDT", time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 NST", time.Date(2006, 1, 2, 18, 34, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 CST", time.Date(2006, 1, 2, 21, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 IST", time.Date(2006, 1, 2, 9, 34, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true, AbbreviationOffsets: map[string]int{"CST": 8 * 3600}}, "2006-01-02 15:04:05 CST", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true, AbbreviationOffsets: map[string]int{"CST": 8 * 3600}, StrictAbbreviations: true}, "2006-01-02 15:04:05 CST", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true, AbbreviationOffsets: map[string]int{"XYZT": -3600}}, "2006-01-02 15:04:05 XYZT", time.Date(2006, 1, 2, 16, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true, StrictAbbreviations: true}, "2006-01-02 15:04:05 PST", time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05A", time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05M", time.Date(2006, 1, 2, 3, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05.5N", time.Date(2006, 1, 2, 16, 4, 5, 500000000, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05Y", time.Date(2006, 1, 3, 3, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true, AbbreviationOffsets: map[string]int{"MYZONE": 3600}}, "2006-01-02 15:04:05 MYZONE", time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true, AbbreviationOffsets: map[string]int{"Q": 3600}}, "2006-01-02 15:04:05 Q", time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC), false},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05+08:00", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+
+		{Parser{}, "2006-01-02 15:04:05 PST", time.Time{}, true},
+		{Parser{Abbreviations: true, StrictAbbreviations: true}, "2006-01-02 15:04:05 CST", time.Time{}, true},
+		{Parser{Abbreviations: true, StrictAbbreviations: true}, "2006-01-02 15:04:05 IST", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 J", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05 M", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02T15:04:05 Z", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 pst", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 XYZ", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05  PST", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 PSTPST", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 ", time.Time{}, true},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 PST+08:00", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserZoneNames(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		zone   string
+		err    bool
+	}{
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 America/New_York", time.Date(2026, 7, 1, 16, 0, 0, 0, time.UTC), "America/New_York", false},
+		{Parser{ZoneNames: true}, "2026-01-01T12:00:00 America/New_York", time.Date(2026, 1, 1, 17, 0, 0, 0, time.UTC), "America/New_York", false},
+		{Parser{ZoneNames: true}, "2026-03-08 02:30:00 America/New_York", time.Date(2026, 3, 8, 2, 30, 0, 0, newYork), "America/New_York", false},
+		{Parser{ZoneNames: true}, "2026-11-01 01:30:00 America/New_York", time.Date(2026, 11, 1, 1, 30, 0, 0, newYork), "America/New_York", false},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00.25 Asia/Kolkata", time.Date(2026, 7, 1, 6, 30, 0, 250000000, time.UTC), "Asia/Kolkata", false},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 America/Argentina/Buenos_Aires", time.Date(2026, 7, 1, 15, 0, 0, 0, time.UTC), "America/Argentina/Buenos_Aires", false},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 Etc/GMT+5", time.Date(2026, 7, 1, 17, 0, 0, 0, time.UTC), "Etc/GMT+5", false},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 UTC", time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC), "UTC", false},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00+01:00", time.Date(2026, 7, 1, 11, 0, 0, 0, time.UTC), "", false},
+		{Parser{ZoneNames: true, Abbreviations: true}, "2026-07-01 12:00:00 EST", time.Date(2026, 7, 1, 17, 0, 0, 0, time.UTC), "", false},
+
+		{Parser{}, "2026-07-01 12:00:00 America/New_York", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 America/Nowhere", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00America/New_York", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00  America/New_York", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 America/New_York ", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 ../zoneinfo/UTC", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 /usr/share/zoneinfo/UTC", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 ", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 Local", time.Time{}, "", true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.ParseResult(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got.Time) || got.Zone != tt.zone {
+			t.Fatalf("case %d: got: %+v, expect: %+v %s, value: %s", i, got, tt.expect, tt.zone, tt.value)
+		}
+		if tt.zone != "" && got.Time.Location().String() != tt.zone {
+			t.Fatalf("case %d: got location: %s, expect: %s, value: %s", i, got.Time.Location(), tt.zone, tt.value)
+		}
+	}
+}
+
+func TestParserLenientOffsets(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+8", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+8:00", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05-5", time.Date(2006, 1, 2, 20, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05.5-5:30", time.Date(2006, 1, 2, 20, 34, 5, 500000000, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02 15:04:05 UTC+8", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02 15:04:05 GMT-05:00", time.Date(2006, 1, 2, 20, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02 15:04:05GMT-0500", time.Date(2006, 1, 2, 20, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02 15:04:05 UT+1", time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02 15:04:05 UTC", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02 15:04:05 GMT", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "1890-01-02T15:04:05+05:53:28", time.Date(1890, 1, 2, 9, 10, 37, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "1890-01-02T15:04:05+055328", time.Date(1890, 1, 2, 9, 10, 37, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05-13:00", time.Date(2006, 1, 3, 4, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+23:59", time.Date(2006, 1, 1, 15, 5, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05-23", time.Date(2006, 1, 3, 14, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+08:00", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{LenientOffsets: true, Annotations: true}, "2006-01-02T15:04:05+8[u-ca=gregory]", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), false},
+
+		{Parser{}, "2006-01-02T15:04:05+8", time.Time{}, true},
+		{Parser{}, "2006-01-02T15:04:05-13:00", time.Time{}, true},
+		{Parser{}, "2006-01-02 15:04:05 UTC+8", time.Time{}, true},
+		{Parser{}, "1890-01-02T15:04:05+05:53:28", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+24", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+8:0", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+8:60", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+800", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+08:00:6", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+123:00", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+:00", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05+", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05 UTC 8", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05 UTCX", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05 utc", time.Time{}, true},
+		{Parser{LenientOffsets: true}, "2006-01-02T15:04:05  +8", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserFraction(t *testing.T) {
+	truncate := Parser{Fraction: FractionTruncate}
+	round := Parser{Fraction: FractionRound}
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{}, "2006-01-02T15:04:05.123456789Z", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), false},
+		{Parser{}, "2006-01-02T15:04:05.1234567Z", time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC), false},
+		{truncate, "2006-01-02T15:04:05.123456789999Z", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), false},
+		{truncate, "2006-01-02 15:04:05.123456789012", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), false},
+		{truncate, "2006-01-02T23:59:59.9999999999+01:00", time.Date(2006, 1, 2, 22, 59, 59, 999999999, time.UTC), false},
+		{round, "2006-01-02T15:04:05.1234567894Z", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), false},
+		{round, "2006-01-02T15:04:05.1234567896Z", time.Date(2006, 1, 2, 15, 4, 5, 123456790, time.UTC), false},
+		{round, "2006-01-02T15:04:05.1234567895Z", time.Date(2006, 1, 2, 15, 4, 5, 123456790, time.UTC), false},
+		{round, "2006-01-02T15:04:05.1234567885Z", time.Date(2006, 1, 2, 15, 4, 5, 123456788, time.UTC), false},
+		{round, "2006-01-02T15:04:05.12345678850001Z", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), false},
+		{round, "2006-01-02T15:04:05.123456788499999", time.Date(2006, 1, 2, 15, 4, 5, 123456788, time.UTC), false},
+		{round, "2006-01-02T15:04:05,0000000005Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{round, "2006-01-02T23:59:59.9999999999Z", time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), false},
+		{round, "2006-12-31T23:59:59.99999999950-01:00", time.Date(2007, 1, 1, 1, 0, 0, 0, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll}, "2016-12-31T23:59:60.9999999999Z", time.Date(2017, 1, 1, 0, 0, 1, 0, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondClamp}, "2016-12-31T23:59:60.9999999999Z", time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll, LeapSecondTable: true}, "2016-12-31T23:59:60.9999999999Z", time.Date(2017, 1, 1, 0, 0, 1, 0, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll, LeapSecondTable: true}, "2017-01-01T08:59:60.9999999999+09:00", time.Date(2017, 1, 1, 0, 0, 1, 0, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondClamp, LeapSecondTable: true}, "2016-12-31T23:59:60.9999999999Z", time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll}, "2006-06-15T12:34:60.9999999999Z", time.Date(2006, 6, 15, 12, 35, 1, 0, time.UTC), false},
+		{Parser{Fraction: FractionTruncate, LeapSecond: LeapSecondRoll}, "2016-12-31T23:59:60.9999999999Z", time.Date(2017, 1, 1, 0, 0, 0, 999999999, time.UTC), false},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll, Strict: true}, "2016-12-31T23:59:60.9999999999Z", time.Date(2017, 1, 1, 0, 0, 1, 0, time.UTC), false},
+		{Parser{Fraction: FractionTruncate, EndOfDay: true}, "2006-01-02T24:00:00.0000000000Z", time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC), false},
+		{Parser{Strict: true}, "2006-01-02T15:04:05.123456789999Z", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), false},
+		{Parser{Strict: true, Fraction: FractionRound}, "2006-01-02T15:04:05.123456789999Z", time.Date(2006, 1, 2, 15, 4, 5, 123456790, time.UTC), false},
+
+		{Parser{}, "2006-01-02T15:04:05.1234567890Z", time.Time{}, true},
+		{Parser{}, "2006-01-02T15:04:05.1234567890", time.Time{}, true},
+		{Parser{}, "2006-01-02T15:04:05.123456789012", time.Time{}, true},
+		{truncate, "2006-01-02T15:04:05.123456789012x", time.Time{}, true},
+		{Parser{Fraction: FractionTruncate, EndOfDay: true}, "2006-01-02T24:00:00.0000000001Z", time.Time{}, true},
+		{Parser{Fraction: FractionRound}, "2016-12-31T23:59:60.9999999999Z", time.Time{}, true},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll, LeapSecondTable: true}, "2006-06-15T12:34:60.9999999999Z", time.Time{}, true},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondClamp, LeapSecondTable: true}, "2006-06-15T12:34:60.9999999999Z", time.Time{}, true},
+		{Parser{Fraction: FractionRound, LeapSecond: LeapSecondRoll, LeapSecondTable: true}, "2006-06-15T12:34:60.9999999999", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+
+	r, err := truncate.ParseResult("2006-01-02T15:04:05.123456789012Z")
+	if err != nil || r.Precision != PrecisionNanosecond+3 {
+		t.Fatalf("got: %+v %v, expect precision: %d", r, err, PrecisionNanosecond+3)
+	}
+}
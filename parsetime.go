@@ -7,6 +7,17 @@ import (
 
 var errParse = errors.New("could not parse time")
 
+// ErrRange is returned for a well-formed time that time.Time cannot represent.
+var ErrRange = errors.New("time out of range")
+
+// ErrOutOfWindow is returned for a well-formed time outside the window accepted by a Parser.
+var ErrOutOfWindow = errors.New("time out of accepted window")
+
+var defaultParser Parser
+
+// reducedParser is the default parser with reduced precision, for formats where it is common.
+var reducedParser = Parser{ReducedPrecision: true}
+
 // ParseInLocation is like time.ParseInLocation.
 //
 // The result is the given location.
@@ -19,7 +30,7 @@ var errParse = errors.New("could not parse time")
 //
 // _, locOffset := time.Now().In(loc).Zone()
 func ParseInLocation(s string, loc *time.Location, locOffset int) (time.Time, error) {
-	t, err := parse([]byte(s), locOffset)
+	t, err := parseDefault([]byte(s), locOffset)
 	if err != nil {
 		return time.Time{}, nil
 	}
@@ -33,12 +44,12 @@ func ParseInLocation(s string, loc *time.Location, locOffset int) (time.Time, er
 // In the absence of a time zone information,
 // Parse interprets the time as in UTC.
 func Parse(s string) (time.Time, error) {
-	return parse([]byte(s), 0)
+	return parseDefault([]byte(s), 0)
 }
 
 // ParseBytesInLocation is like time.ParseInLocation but accepting bytes with better performance of about 4 ns.
 func ParseBytesInLocation(s []byte, loc *time.Location, locOffset int) (time.Time, error) {
-	t, err := parse(s, locOffset)
+	t, err := parseDefault(s, locOffset)
 	if err != nil {
 		return time.Time{}, nil
 	}
@@ -48,36 +59,165 @@ func ParseBytesInLocation(s []byte, loc *time.Location, locOffset int) (time.Tim
 
 // ParseBytes is like time.Parse but accepting bytes with better performance of about 4 ns.
 func ParseBytes(s []byte) (time.Time, error) {
-	return parse(s, 0)
+	return parseDefault(s, 0)
+}
+
+// parseDefault parses s like the default Parser, trying parseFast first.
+func parseDefault(s []byte, locOffset int) (time.Time, error) {
+	if t, ok := parseFast(s, locOffset); ok {
+		return t, nil
+	}
+	return defaultParser.parseDate(s, locOffset, &details{})
+}
+
+// parseFast parses the common forms of Parse, a date, optionally followed by a time of
+// day with up to nine fractional digits and an offset or Z, without the bookkeeping of
+// the options and details that parseDate does. It reports false for any other text,
+// including invalid text, which is left to parseDate.
+func parseFast(s []byte, locOffset int) (time.Time, bool) {
+	sLen := len(s)
+
+	if sLen < 10 || s[4] != '-' || s[7] != '-' || nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
+		return time.Time{}, false
+	}
+	year := int(s[0]-'0')*1e3 + int(s[1]-'0')*1e2 + int(s[2]-'0')*1e1 + int(s[3]-'0')
+	month := atoi2MinMax(s[5:7], 1, 12)
+	if month == -1 {
+		return time.Time{}, false
+	}
+	day := atoi2MinMax(s[8:10], 1, daysIn(month, year))
+	if day == -1 {
+		return time.Time{}, false
+	}
+
+	var daysEpoc uint64
+	var leap bool
+	if year >= unixEpoc && year < unixEpoc+cacheYears {
+		daysEpoc = yearDays[year-unixEpoc]
+		leap = yearLeap[year-unixEpoc]
+	} else {
+		daysEpoc = daysSinceEpoch(year)
+		leap = isLeap(year)
+	}
+	daysEpoc += uint64(daysBefore[month-1]) + uint64(day-1)
+	if leap && month >= 3 {
+		daysEpoc++
+	}
+	unix := int64(daysEpoc*secondsPerDay) + (absoluteToInternal + internalToUnix)
+
+	if sLen == 10 {
+		return time.Unix(unix-int64(locOffset), 0), true
+	}
+	if sLen < 19 || s[13] != ':' || s[16] != ':' || s[10] != 'T' && s[10] != ' ' {
+		return time.Time{}, false
+	}
+	hour := atoi2MinMax(s[11:13], 0, 23)
+	min := atoi2MinMax(s[14:16], 0, 59)
+	sec := atoi2MinMax(s[17:19], 0, 59)
+	if hour == -1 || min == -1 || sec == -1 {
+		return time.Time{}, false
+	}
+	unix += int64(hour*secondsPerHour + min*secondsPerMinute + sec)
+
+	// Fraction of up to nine digits.
+	nsec, i := 0, 19
+	if i < sLen && (s[i] == '.' || s[i] == ',') {
+		for i++; i < sLen && !nd(s[i]); i++ {
+			nsec = nsec*10 + int(s[i]-'0')
+		}
+		if i == 20 || i > 29 {
+			return time.Time{}, false
+		}
+		nsec *= fracScale[i-21]
+	}
+
+	if i == sLen {
+		return time.Unix(unix-int64(locOffset), int64(nsec)), true
+	}
+	offset, ok := atoiOffset(s[i:])
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(unix-int64(offset), int64(nsec)), true
+}
+
+// fracScale is the factor giving nanoseconds from a fraction of 1 to 9 digits, by
+// the number of digits less one.
+var fracScale = [...]int{1e8, 1e7, 1e6, 1e5, 1e4, 1e3, 1e2, 1e1, 1}
+
+// parse parses s with the options of p. The package functions use parseDefault instead,
+// since the default Parser has none.
+func (p *Parser) parse(s []byte, locOffset int, d *details) (time.Time, error) {
+	if p.Trim || p.Unicode || p.Strict || p.window() || p.separators() {
+		return p.parseOptions(s, locOffset, d)
+	}
+	return p.parseDate(s, locOffset, d)
+}
+
+// parseOptions parses s with the options of p that change the accepted text.
+func (p *Parser) parseOptions(s []byte, locOffset int, d *details) (time.Time, error) {
+	if p.window() {
+		return p.parseWindow(s, locOffset, d)
+	}
+	if p.Trim {
+		s = trim(s)
+	}
+	if p.Unicode && !isASCII(s) {
+		return p.parseUnicode(s, locOffset, d)
+	}
+	if p.Strict {
+		return p.parseStrict(s, d)
+	}
+	if p.separators() {
+		return p.parseSeparated(s, locOffset, d)
+	}
+	return p.parseDate(s, locOffset, d)
 }
 
-func parse(s []byte, locOffset int) (time.Time, error) {
+// parseDate parses s as a date, optionally followed by a time of day.
+func (p *Parser) parseDate(s []byte, locOffset int, d *details) (time.Time, error) {
+	var year int
+
+	orig := s
+
+	signed := len(s) > 0 && (s[0] == '+' || s[0] == '-') && p.YearDigits > 0
+	if signed {
+		var n int
+		if year, n = p.atoiYear(s); n == 0 {
+			return time.Time{}, errParse
+		}
+		if year < minYear || year > maxYear {
+			return time.Time{}, ErrRange
+		}
+
+		// Align the rest with a four digit year.
+		s = s[n-4:]
+	}
+
 	sLen := len(s)
 
 	if sLen < 10 || s[4] != '-' || s[7] != '-' {
+		if p.ReducedPrecision && (sLen == 4 || sLen == 7 && s[4] == '-') {
+			return p.parseReducedDate(orig, sLen == 7, locOffset, d)
+		}
 		return time.Time{}, errParse
 	}
 
-	var unix int64
-	var a0, a1, a2, a3, a4, a5, a6, a7, a8 int
+	var a0, a1, a2, a3 int
 
-	if nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
-		return time.Time{}, errParse
+	if !signed {
+		if nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
+			return time.Time{}, errParse
+		}
+		a0, a1, a2, a3 = int(s[0]-'0'), int(s[1]-'0'), int(s[2]-'0'), int(s[3]-'0')
+		year = a0*1e3 + a1*1e2 + a2*1e1 + a3
 	}
-	a0, a1, a2, a3 = int(s[0]-'0'), int(s[1]-'0'), int(s[2]-'0'), int(s[3]-'0')
-	year := a0*1e3 + a1*1e2 + a2*1e1 + a3
 	month := atoi2MinMax(s[5:7], 1, 12)
 	if year == -1 || month < 1 || month > 12 {
 		return time.Time{}, errParse
 	}
 
-	// daysIn
-	daysIn := int(daysBefore[month] - daysBefore[month-1])
-	if month == 2 && isLeap(year) {
-		daysIn = 29
-	}
-
-	day := atoi2MinMax(s[8:10], 1, daysIn)
+	day := atoi2MinMax(s[8:10], 1, daysIn(month, year))
 	if day == -1 {
 		return time.Time{}, errParse
 	}
@@ -86,38 +226,74 @@ func parse(s []byte, locOffset int) (time.Time, error) {
 	var daysEpoc uint64
 	var leap bool
 
-	if year >= unixEpoc && year < unixEpoc+cacheYears && 0 <= month && month < 12 {
-		daysEpoc = yearDays[year-unixEpoc] + uint64(daysBefore[month-1]) + uint64(day-1)
+	if year >= unixEpoc && year < unixEpoc+cacheYears {
+		daysEpoc = yearDays[year-unixEpoc]
 		leap = yearLeap[year-unixEpoc]
 	} else {
 		daysEpoc = daysSinceEpoch(year)
 		leap = isLeap(year)
 	}
+	daysEpoc += uint64(daysBefore[month-1]) + uint64(day-1)
 
 	if leap && month >= 3 {
 		daysEpoc++
 	}
 
 	if sLen == 10 {
+		d.prec = PrecisionDay
+		return time.Unix(int64(daysEpoc*secondsPerDay)+(absoluteToInternal+internalToUnix)-int64(locOffset), 0), nil
+	}
+	return p.parseClock(s[10:], daysEpoc, locOffset, d)
+}
+
+// parseClock parses the time of day, fraction and time zone following a date,
+// which is given as days since the absolute epoch.
+// An empty s is midnight of that date.
+func (p *Parser) parseClock(s []byte, daysEpoc uint64, locOffset int, d *details) (time.Time, error) {
+	sLen := len(s)
+
+	var unix int64
+	var a0, a1, a2, a3, a4, a5, a6, a7, a8 int
+
+	if sLen == 0 {
+		d.prec = PrecisionDay
 		unix = int64(daysEpoc*secondsPerDay) + (absoluteToInternal + internalToUnix)
 		return time.Unix(unix-int64(locOffset), 0), nil
 	}
 
-	if sLen < 19 || s[13] != ':' || s[16] != ':' || s[10] != 'T' && s[10] != ' ' {
+	if sLen < 9 || s[3] != ':' || s[6] != ':' || s[0] != 'T' && s[0] != ' ' {
+		if p.ReducedPrecision && sLen >= 3 && (s[0] == 'T' || s[0] == ' ') {
+			return p.parseReducedClock(s, daysEpoc, locOffset, d)
+		}
 		return time.Time{}, errParse
 	}
 
-	hour := atoi2MinMax(s[11:13], 0, 23)
-	min := atoi2MinMax(s[14:16], 0, 59)
-	sec := atoi2MinMax(s[17:19], 0, 59)
+	hour := atoi2MinMax(s[1:3], 0, 23)
+	min := atoi2MinMax(s[4:6], 0, 59)
+	sec := atoi2MinMax(s[7:9], 0, 59)
+
+	// Leap second, which is computed from second 59.
+	leapSec := false
+	if sec == -1 && s[7] == '6' && s[8] == '0' && p.LeapSecond != LeapSecondReject {
+		sec, leapSec = 59, true
+	}
+
+	// End of day, which is midnight of the next day.
+	endOfDay := false
+	if hour == -1 && s[1] == '2' && s[2] == '4' && min == 0 && sec == 0 && p.EndOfDay {
+		hour, endOfDay = 0, true
+		daysEpoc++
+	}
+
 	if hour == -1 || min == -1 || sec == -1 {
 		return time.Time{}, errParse
 	}
 
-	var nsec, tzSign, tzH, tzM, tzIdx, tzOffset int
+	var nsec, tzIdx int
+	exact := true
 
 	// nsec
-	s = s[19:]
+	s = s[9:]
 	sLen = len(s)
 	tzIdx = 0
 	if sLen > 1 {
@@ -210,91 +386,119 @@ func parse(s []byte, locOffset int) (time.Time, error) {
 				var c byte
 				var mult int = 1e9
 				for tzIdx = 1; tzIdx < sLen; tzIdx++ {
-					if tzIdx > 10 {
-						return time.Time{}, errParse
-					}
-
 					c = s[tzIdx]
-					if c >= '0' && c <= '9' {
+					if c < '0' || c > '9' {
+						break
+					}
+					if tzIdx <= 9 {
 						val = val*10 + int(c-'0')
 						mult /= 10
-					} else {
-						break
 					}
 				}
 				nsec = val * mult
+
+				// Digits beyond nanoseconds.
+				if tzIdx > 10 {
+					var ok bool
+					if d.sub {
+						nsec, exact, ok = p.attoFraction(nsec, s[10:tzIdx], d)
+					} else {
+						nsec, exact, ok = p.longFraction(nsec, s[10:tzIdx])
+					}
+					if !ok {
+						return time.Time{}, errParse
+					}
+					if nsec == 1e9 && !leapSec {
+						// Rounding carries into the next second. After second 60, the carry
+						// is added by leapSecond, once the policy and table are applied.
+						nsec = 0
+						sec++
+					}
+				}
 			}
-		} else if s[0] != 'z' && s[0] != 'Z' && s[0] != '+' && s[0] != '-' {
-			return time.Time{}, errParse
 		}
 	}
 
+	if endOfDay && (nsec != 0 || !exact) {
+		return time.Time{}, errParse
+	}
+	if leapSec && d.sub && p.LeapSecond == LeapSecondClamp {
+		d.atto = 1e9 - 1
+	}
+
+	d.prec = PrecisionSecond
+	if tzIdx > 1 {
+		d.prec += Precision(tzIdx - 1)
+	}
+
 	if sLen == 0 || sLen == tzIdx {
 		// No tz information.
 		unix = int64(daysEpoc*secondsPerDay+uint64(hour*secondsPerHour+min*secondsPerMinute+sec)) + (absoluteToInternal + internalToUnix)
+		if leapSec {
+			return p.leapSecond(unix-int64(locOffset), nsec)
+		}
 		return time.Unix(unix-int64(locOffset), int64(nsec)), nil
 	}
 
-	// Timezone sign.
-	if tzIdx < 0 || tzIdx >= sLen {
+	if nsec < 0 {
 		return time.Time{}, errParse
 	}
 
-	switch {
-	case sLen == 0 || sLen == tzIdx:
-		tzSign = 1
-	case s[sLen-1] == 'z' || s[sLen-1] == 'Z' || s[tzIdx] == '+':
-		tzSign = 1
-	case s[tzIdx] == '-':
-		tzSign = -1
-	default:
-		tzSign = 0
-	}
-
-	// Timezone hour and minute.
-	s = s[tzIdx:]
-	if len(s) > 0 {
-		c := s[0]
-		if c == 'z' || c == 'Z' {
-			tzOffset = 0
-		} else {
-			if c != '+' && c != '-' {
-				return time.Time{}, errParse
-			}
+	unix = int64(daysEpoc*secondsPerDay+uint64(hour*secondsPerHour+min*secondsPerMinute+sec)) + (absoluteToInternal + internalToUnix)
 
-			switch len(s) {
-			case 6:
-				tzH = atoi2MinMax(s[1:3], 0, 14)
-				tzM = atoi2MinMax(s[4:6], 0, 59)
-				if s[3] != ':' {
-					tzH = -1
-					tzM = -1
-				}
-			case 5:
-				tzH = atoi2MinMax(s[1:3], 0, 14)
-				tzM = atoi2MinMax(s[3:5], 0, 59)
-			case 3:
-				tzH = atoi2MinMax(s[1:3], 0, 14)
-				tzM = 0
-			default:
-				tzH = -1
-				tzM = -1
-			}
+	// Timezone.
+	tzOffset, ok := atoiOffset(s[tzIdx:])
+	if !ok {
+		if !p.namedZones() {
+			return time.Time{}, errParse
+		}
+		if tzOffset, ok = p.parseZone(s[tzIdx:], unix, d); !ok {
+			return time.Time{}, errParse
 		}
 	}
+	d.offset, d.zoned = tzOffset, true
 
-	if nsec < 0 || tzH == -1 || tzM == -1 || tzSign == 0 {
-		return time.Time{}, errParse
+	if d.loc != nil {
+		return p.inZone(unix-int64(tzOffset), nsec, leapSec, d.loc)
+	}
+	if leapSec {
+		return p.leapSecond(unix-int64(tzOffset), nsec)
 	}
+	return time.Unix(unix-int64(tzOffset), int64(nsec)), nil
+}
 
-	if tzSign == -1 && tzH > 12 {
-		return time.Time{}, errParse
+// atoiOffset parses a time zone of the form "Z", "+hh:mm", "+hhmm" or "+hh",
+// returning its offset in seconds east of UTC.
+func atoiOffset(s []byte) (offset int, ok bool) {
+	var h, m int
+
+	switch len(s) {
+	case 1:
+		return 0, s[0] == 'Z' || s[0] == 'z'
+	case 6:
+		if s[3] != ':' {
+			return 0, false
+		}
+		h, m = atoi2MinMax(s[1:3], 0, 14), atoi2MinMax(s[4:6], 0, 59)
+	case 5:
+		h, m = atoi2MinMax(s[1:3], 0, 14), atoi2MinMax(s[3:5], 0, 59)
+	case 3:
+		h, m = atoi2MinMax(s[1:3], 0, 14), 0
+	default:
+		return 0, false
 	}
 
-	tzOffset = tzSign * (tzH*3600 + tzM*60)
+	if h == -1 || m == -1 {
+		return 0, false
+	}
 
-	unix = int64(daysEpoc*secondsPerDay+uint64(hour*secondsPerHour+min*secondsPerMinute+sec)) + (absoluteToInternal + internalToUnix)
-	return time.Unix(unix-int64(tzOffset), int64(nsec)), nil
+	switch {
+	case s[0] == '+':
+		return h*3600 + m*60, true
+	case s[0] == '-' && h <= 12:
+		return -(h*3600 + m*60), true
+	}
+	return 0, false
 }
 
 func atoi2MinMax(s []byte, min, max int) (x int) {
@@ -317,6 +521,65 @@ func nd(c byte) bool {
 	return c < '0' || c > '9'
 }
 
+// atoiFrac parses the leading decimal digits of s as a fraction, returning it in
+// billionths, truncated after nine digits, and the number of digits.
+func atoiFrac(s []byte) (x int, n int) {
+	mult := int(1e9)
+	for ; n < len(s) && !nd(s[n]); n++ {
+		if mult > 1 {
+			mult /= 10
+			x += int(s[n]-'0') * mult
+		}
+	}
+	return x, n
+}
+
+// appendFrac appends the billionths x to b as decimal digits without trailing zeros.
+func appendFrac(b []byte, x int) []byte {
+	var buf [9]byte
+	n := 0
+	for i := len(buf) - 1; i >= 0; i-- {
+		buf[i] = byte('0' + x%10)
+		x /= 10
+		if n == 0 && buf[i] != '0' {
+			n = i + 1
+		}
+	}
+	return append(b, buf[:n]...)
+}
+
+// fixedZone returns the location of a time zone offset in seconds east of UTC.
+func fixedZone(offset int) *time.Location {
+	if offset == 0 {
+		return time.UTC
+	}
+	return time.FixedZone("", offset)
+}
+
+// appendInt appends the decimal x to b, zero padded to width digits.
+func appendInt(b []byte, x int, width int) []byte {
+	u := uint(x)
+	if x < 0 {
+		b = append(b, '-')
+		u = uint(-x)
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		buf[i] = byte('0' + u%10)
+		u /= 10
+	}
+	i--
+	buf[i] = byte('0' + u)
+
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, '0')
+	}
+	return append(b, buf[i:]...)
+}
+
 // The following code is from the stdlib time.
 
 const (
@@ -390,6 +653,20 @@ var daysBefore = [...]int32{
 	31 + 28 + 31 + 30 + 31 + 30 + 31 + 31 + 30 + 31 + 30 + 31,
 }
 
+// daysIn returns the number of days in month of year.
+func daysIn(month int, year int) int {
+	if month == 2 && isLeap(year) {
+		return 29
+	}
+	return int(daysBefore[month] - daysBefore[month-1])
+}
+
+// The years that time.Time can represent in full, whatever the time zone offset.
+const (
+	minYear = absoluteZeroYear + 1
+	maxYear = 292277026595
+)
+
 func isLeap(year int) bool {
 	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
 }
@@ -51,6 +51,10 @@ func TestParse(t *testing.T) {
 		{time.RFC3339Nano, fmt.Sprintf("%04d-%02d-%02dT%02d:%02d:%02d.%d+%02d:%02d", year, month, day, hour, min, sec, nsec9, tzH, 30), time.Date(year, month, day, hour, min, sec, nsec9, time.Local).Add(-30 * time.Minute), false},
 		{time.RFC3339Nano, fmt.Sprintf("%04d-%02d-%02dT%02d:%02d:%02d+%02d:%02d", year, month, day, hour, min, sec, tzH, tzM), time.Date(year, month, day, hour, min, sec, 0, time.Local), false},
 		{time.DateOnly, fmt.Sprintf("%04d-%02d-%02d", year, month, day), time.Date(year, month, day, 0, 0, 0, 0, time.UTC), false},
+		{time.DateOnly, fmt.Sprintf("%04d-%02d-%02d", year, 12, 31), time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC), false},
+		{time.DateTime, fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", 1960, 5, 5, hour, min, sec), time.Date(1960, 5, 5, hour, min, sec, 0, time.UTC), false},
+		{time.DateOnly, fmt.Sprintf("%04d-%02d-%02d", 2400, 2, 29), time.Date(2400, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{time.DateOnly, fmt.Sprintf("%04d-%02d-%02d", 1, 1, 1), time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), false},
 
 		{"", fmt.Sprintf("%d-%02d-%02dT%02d:%02d:%02d", 2, month, day, hour, min, sec), now, true},
 		{"", fmt.Sprintf("%04d-%02d-%02dt%02d:%02d:%02d", year, month, day, hour, min, sec), now, true},
@@ -186,3 +190,64 @@ func TestParseInLocation(t *testing.T) {
 		}
 	}
 }
+
+func TestParseAllocs(t *testing.T) {
+	values := []string{
+		"2006-01-02",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04:05.123+01:00",
+		"2006-01-02T15:04:05Z",
+		"2006-01-02T15:04:05.123456789+01:00",
+	}
+
+	for _, s := range values {
+		b := []byte(s)
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := Parse(s); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ParseBytes(b); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Fatalf("got: %v allocs, expect: 0, value: %s", allocs, s)
+		}
+	}
+}
+
+func TestParseFast(t *testing.T) {
+	values := []string{
+		"2006-01-02",
+		"0000-01-01",
+		"9999-12-31T23:59:59.999999999+14:00",
+		"2024-02-29 00:00:00",
+		"2006-02-29",
+		"2006-01-02T15:04:05",
+		"2006-01-02T15:04:05,123",
+		"2006-01-02T15:04:05.1z",
+		"2006-01-02T15:04:05.123456789-12:00",
+		"2006-01-02T15:04:05.1234567891Z",
+		"2006-01-02T15:04:05.Z",
+		"2006-01-02T15:04:05+0800",
+		"2006-01-02T15:04:05+08",
+		"2006-01-02T15:04:05-13:00",
+		"2006-01-02T15:04:60Z",
+		"2006-01-02T24:00:00Z",
+		"2006-01-02t15:04:05Z",
+		"2006-01-02T15:04:05Z ",
+		"2006-01-02T15:04",
+		"+2006-01-02",
+	}
+
+	for _, s := range values {
+		got, ok := parseFast([]byte(s), 3600)
+		expect, err := defaultParser.parseDate([]byte(s), 3600, &details{})
+		if ok && (err != nil || !got.Equal(expect)) {
+			t.Fatalf("got: %+v, expect: %+v %v, value: %s", got, expect, err, s)
+		}
+		if !ok && err == nil {
+			t.Fatalf("got: not parsed, expect: %+v, value: %s", expect, s)
+		}
+	}
+}
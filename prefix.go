@@ -0,0 +1,90 @@
+package parsetime
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// maxPrefix is the longest timestamp ParsePrefix looks for.
+	maxPrefix = 64
+
+	// maxZonedPrefix is the longest timestamp ParsePrefix looks for when time zone
+	// names are accepted.
+	maxZonedPrefix = 256
+)
+
+// ParsePrefix parses the longest timestamp at the start of b, in the formats Parse
+// accepts, returning the time and its length in bytes.
+//
+// The timestamp must not end within a word or number, so that neither
+// "2006-01-02T15:04:05Z1" nor "2006-01-02T15:04:05-07:00:00" matches, and is typically
+// followed by a space, as in "2006-01-02T15:04:05Z INFO".
+func ParsePrefix(b []byte) (t time.Time, n int, err error) {
+	return defaultParser.ParsePrefix(b)
+}
+
+// ParsePrefix is like the package function ParsePrefix. Trim does not apply.
+func (p *Parser) ParsePrefix(b []byte) (t time.Time, n int, err error) {
+	return p.parsePrefix(b, false, &details{})
+}
+
+// parsePrefix parses the longest timestamp at the start of b, which is a week date
+// like ParseWeek accepts if week is set, and the details of the time otherwise.
+func (p *Parser) parsePrefix(b []byte, week bool, d *details) (t time.Time, n int, err error) {
+	q := *p
+	q.Trim = false
+
+	limit := maxPrefix
+	if q.namedZones() {
+		limit = maxZonedPrefix
+	}
+	limit = min(len(b), limit)
+
+	if !q.namedZones() && !q.Unicode {
+		// Without time zone names, a timestamp has no letters other than T, Z and
+		// the W of week dates.
+		for i, c := range b[:limit] {
+			letter := 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+			if c < ' ' || c > '~' || letter && c != 'T' && c != 't' && c != 'Z' && c != 'z' && (c != 'W' || !week) {
+				limit = i
+				break
+			}
+		}
+	}
+
+	err = errParse
+	for n = limit; n > 0; n-- {
+		// A timestamp ends with a digit, a letter or a bracket, and not within a word or
+		// a number, which continues with punctuation and a digit as for FindAll.
+		if !isAlnum(b[n-1]) && b[n-1] != ']' || n < len(b) && !findBoundary(b, n, n+1) && (isAlnum(b[n-1]) || !isAlnum(b[n])) {
+			continue
+		}
+
+		var e error
+		if week {
+			if t, e = parseWeek(b[:n], 0); e == nil && !q.inWindow(t) {
+				e = ErrOutOfWindow
+			}
+		} else {
+			*d = details{}
+			t, e = q.parse(b[:n], 0, d)
+		}
+		if e == nil {
+			return t, n, nil
+		}
+		if e == ErrOutOfWindow {
+			// The longest timestamp is out of the window, rather than a shorter one.
+			return time.Time{}, 0, e
+		}
+		if err == errParse && !errors.Is(e, errParse) {
+			err = e
+		}
+	}
+	return time.Time{}, 0, err
+}
+
+// isAlnum reports whether c is an ASCII letter or digit.
+func isAlnum(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
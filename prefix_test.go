@@ -0,0 +1,62 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		n      int
+		err    error
+	}{
+		{Parser{}, "2006-01-02T15:04:05.123Z INFO msg", time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC), 24, nil},
+		{Parser{}, "2006-01-02 15:04:05,123 INFO msg", time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC), 23, nil},
+		{Parser{}, "2006-01-02 15:04:05+08:00 msg 2007-01-02", time.Date(2006, 1, 2, 7, 4, 5, 0, time.UTC), 25, nil},
+		{Parser{}, "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 20, nil},
+		{Parser{}, "2006-01-02 msg", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), 10, nil},
+		{Parser{}, "2006-01-02T15:04:05Z\n", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 20, nil},
+		{Parser{}, "2006-01-02T15:04:05Z: msg", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 20, nil},
+		{Parser{}, "2006-01-02 15:04:05 - 10:00", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 19, nil},
+		{Parser{}, "2006-01-02T15:04:05Z. msg", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 20, nil},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 PST msg", time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC), 23, nil},
+		{Parser{Abbreviations: true}, "2006-01-02 15:04:05 INFO msg", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), 19, nil},
+		{Parser{Abbreviations: true}, "2026-10-16 14:00:00 A lot", time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC), 19, nil},
+		{Parser{ZoneNames: true}, "2006-07-02 15:04:05 America/New_York msg", time.Date(2006, 7, 2, 19, 4, 5, 0, time.UTC), 36, nil},
+		{Parser{ReducedPrecision: true}, "2006-01 msg", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), 7, nil},
+		{Parser{Trim: true}, " 2006-01-02", time.Time{}, 0, errParse},
+
+		{Parser{}, "2006-01-02T15:04:05Z1", time.Time{}, 0, errParse},
+		{Parser{}, "2006-01-021", time.Time{}, 0, errParse},
+		{Parser{}, "2006-01-02T15:04:05-07:00:00", time.Time{}, 0, errParse},
+		{Parser{}, "2006-01-02T15:04:05.123.4", time.Time{}, 0, errParse},
+		{Parser{}, "msg 2006-01-02", time.Time{}, 0, errParse},
+		{Parser{}, "", time.Time{}, 0, errParse},
+		{Parser{YearDigits: 12}, "+292277026596-01-01 msg", time.Time{}, 0, ErrRange},
+	}
+
+	for i, tt := range tests {
+		got, n, err := tt.p.ParsePrefix([]byte(tt.value))
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) || n != 0 {
+				t.Fatalf("case %d: got error: %v %d, expect: %v, value: %s", i, err, n, tt.err, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) || n != tt.n {
+			t.Fatalf("case %d: got: %+v %d, expect: %+v %d, value: %s", i, got, n, tt.expect, tt.n, tt.value)
+		}
+	}
+
+	if _, n, err := ParsePrefix([]byte("2006-01-02T15:04:05Z msg")); err != nil || n != 20 {
+		t.Fatalf("got: %d %v, expect: 20", n, err)
+	}
+}
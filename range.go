@@ -0,0 +1,95 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// ParseRange parses s like Parse, accepting reduced precision as in
+// Parser.ReducedPrecision, and returns the half-open range [start, end) of the
+// times that s denotes at the precision present in the text.
+//
+// For example "2026-10" is the whole month, from 2026-10-01 to 2026-11-01,
+// "2026-10-16T14" is the whole hour, and "2026-10-16T14:00:00.12" is 10 milliseconds.
+// Months and years have their calendar length, leap years included.
+//
+// In the absence of time zone information, ParseRange interprets the time as in UTC.
+func ParseRange(s string) (start, end time.Time, err error) {
+	return defaultParser.parseRange([]byte(s), nil)
+}
+
+// ParseRangeInLocation is like ParseRange but, in the absence of time zone
+// information, interprets s as wall clock time in loc, so that for example a day
+// lasts 23 or 25 hours across daylight saving time transitions.
+// The results are in loc.
+func ParseRangeInLocation(s string, loc *time.Location) (start, end time.Time, err error) {
+	return defaultParser.parseRange([]byte(s), loc)
+}
+
+// ParseRange is like the package function ParseRange.
+func (p *Parser) ParseRange(s string) (start, end time.Time, err error) {
+	return p.parseRange([]byte(s), nil)
+}
+
+// ParseRangeInLocation is like the package function ParseRangeInLocation.
+func (p *Parser) ParseRangeInLocation(s string, loc *time.Location) (start, end time.Time, err error) {
+	return p.parseRange([]byte(s), loc)
+}
+
+func (p *Parser) parseRange(s []byte, loc *time.Location) (start, end time.Time, err error) {
+	q := *p
+	q.ReducedPrecision = true
+	q.NotBefore, q.NotAfter, q.MaxPast, q.MaxFuture = time.Time{}, time.Time{}, 0, 0
+
+	var d details
+	if start, err = q.parse(s, 0, &d); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	// Wall clock of the text.
+	wall := start.Add(time.Duration(d.offset) * time.Second).UTC()
+	year, month, day := wall.Date()
+	days, exact := span(d.prec, year, int(month))
+
+	if loc == nil || d.zoned {
+		end = start.Add(time.Duration(days)*24*time.Hour + exact)
+		if loc != nil {
+			start, end = start.In(loc), end.In(loc)
+		}
+	} else {
+		hour, min, sec := wall.Clock()
+		start = time.Date(year, month, day, hour, min, sec, wall.Nanosecond(), loc)
+		end = time.Date(year, month, day+days, hour, min, sec, wall.Nanosecond(), loc).Add(exact)
+	}
+
+	// The window applies to the start, which depends on loc for a time without zone.
+	if !p.inWindow(start) {
+		return time.Time{}, time.Time{}, ErrOutOfWindow
+	}
+	return start, end, nil
+}
+
+// span returns the length of the period of precision prec starting in month of year,
+// in calendar days and exact time.
+func span(prec Precision, year, month int) (days int, exact time.Duration) {
+	switch prec {
+	case PrecisionYear:
+		days = 365
+		if isLeap(year) {
+			days = 366
+		}
+	case PrecisionMonth:
+		days = daysIn(month, year)
+	case PrecisionDay:
+		days = 1
+	case PrecisionHour:
+		exact = time.Hour
+	case PrecisionMinute:
+		exact = time.Minute
+	default:
+		exact = time.Second
+		for n := prec - PrecisionSecond; n > 0 && exact > 1; n-- {
+			exact /= 10
+		}
+	}
+	return days, exact
+}
@@ -0,0 +1,81 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		value string
+		start time.Time
+		end   time.Time
+		err   bool
+	}{
+		{"2026", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2026-10", time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2026-12", time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2024-02", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2026-02", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2026-10-16", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), false},
+		{"2026-10-16T14", time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC), time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC), false},
+		{"2026-10-16T14:05", time.Date(2026, 10, 16, 14, 5, 0, 0, time.UTC), time.Date(2026, 10, 16, 14, 6, 0, 0, time.UTC), false},
+		{"2026-10-16T14:05:06", time.Date(2026, 10, 16, 14, 5, 6, 0, time.UTC), time.Date(2026, 10, 16, 14, 5, 7, 0, time.UTC), false},
+		{"2026-10-16T14:05:06.12", time.Date(2026, 10, 16, 14, 5, 6, 120000000, time.UTC), time.Date(2026, 10, 16, 14, 5, 6, 130000000, time.UTC), false},
+		{"2026-10-16T14:05:06.123456789Z", time.Date(2026, 10, 16, 14, 5, 6, 123456789, time.UTC), time.Date(2026, 10, 16, 14, 5, 6, 123456790, time.UTC), false},
+		{"2026-10-16T14+02:00", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 16, 13, 0, 0, 0, time.UTC), false},
+		{"2026-10-31T23:30-02:00", time.Date(2026, 11, 1, 1, 30, 0, 0, time.UTC), time.Date(2026, 11, 1, 1, 31, 0, 0, time.UTC), false},
+
+		{"2026-13", time.Time{}, time.Time{}, true},
+		{"2026-10-16T", time.Time{}, time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		start, end, err := ParseRange(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Fatalf("case %d: got: %s - %s, expect: %s - %s, value: %s", i, start, end, tt.start, tt.end, tt.value)
+		}
+	}
+}
+
+func TestParseRangeInLocation(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		value string
+		start time.Time
+		end   time.Time
+	}{
+		{"2026-03-08", time.Date(2026, 3, 8, 0, 0, 0, 0, ny), time.Date(2026, 3, 9, 0, 0, 0, 0, ny)},
+		{"2026-11-01", time.Date(2026, 11, 1, 0, 0, 0, 0, ny), time.Date(2026, 11, 2, 0, 0, 0, 0, ny)},
+		{"2026-03", time.Date(2026, 3, 1, 0, 0, 0, 0, ny), time.Date(2026, 4, 1, 0, 0, 0, 0, ny)},
+		{"2026-10-16T14", time.Date(2026, 10, 16, 14, 0, 0, 0, ny), time.Date(2026, 10, 16, 15, 0, 0, 0, ny)},
+		{"2026-10-16T14Z", time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC), time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)},
+	}
+
+	for i, tt := range tests {
+		start, end, err := ParseRangeInLocation(tt.value, ny)
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !start.Equal(tt.start) || !end.Equal(tt.end) || start.Location() != ny || end.Location() != ny {
+			t.Fatalf("case %d: got: %s - %s, expect: %s - %s, value: %s", i, start, end, tt.start, tt.end, tt.value)
+		}
+	}
+
+	if start, end, _ := ParseRangeInLocation("2026-03-08", ny); end.Sub(start) != 23*time.Hour {
+		t.Fatalf("got: %s, expect: 23h", end.Sub(start))
+	}
+}
@@ -0,0 +1,92 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Precision is the smallest unit of time present in a parsed text.
+//
+// Precisions finer than PrecisionSecond count the digits of the fraction of seconds,
+// so that "2006-01-02T15:04:05.123" has precision PrecisionSecond + 3, which is
+// PrecisionMillisecond.
+type Precision int
+
+const (
+	PrecisionYear Precision = iota + 1
+	PrecisionMonth
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+
+	PrecisionMillisecond = PrecisionSecond + 3
+	PrecisionMicrosecond = PrecisionSecond + 6
+	PrecisionNanosecond  = PrecisionSecond + 9
+)
+
+// Result is a parsed time, with details of the text it was parsed from.
+type Result struct {
+	Time time.Time
+
+	// Precision is the smallest unit of time present in the text.
+	Precision Precision
+
+	// Zone is the name of the location given in the text, either in an RFC 9557 suffix
+	// or as an IANA time zone name, such as "Europe/Paris" or "+01:00", or empty if
+	// there is none.
+	Zone string
+}
+
+// details is what parse found in the text besides the time.
+type details struct {
+	prec Precision
+
+	// offset is the time zone offset in seconds east of UTC, if zoned.
+	offset int
+	zoned  bool
+
+	// loc is the location named in the text, if any.
+	loc *time.Location
+
+	// atto is the attoseconds below the nanosecond, recorded only if sub is set.
+	atto int
+	sub  bool
+}
+
+// result returns the Result of t and d.
+func (d *details) result(t time.Time) Result {
+	r := Result{
+		Time:      t,
+		Precision: d.prec,
+	}
+	if d.loc != nil {
+		r.Zone = d.loc.String()
+	}
+	return r
+}
+
+// ParseResult is like Parse but returns details of s along with the time.
+func ParseResult(s string) (Result, error) {
+	return defaultParser.ParseResultBytes([]byte(s))
+}
+
+// ParseResultBytes is like ParseResult but accepting bytes.
+func ParseResultBytes(s []byte) (Result, error) {
+	return defaultParser.ParseResultBytes(s)
+}
+
+// ParseResult is like the package function ParseResult.
+func (p *Parser) ParseResult(s string) (Result, error) {
+	return p.ParseResultBytes([]byte(s))
+}
+
+// ParseResultBytes is like the package function ParseResultBytes.
+func (p *Parser) ParseResultBytes(s []byte) (Result, error) {
+	var d details
+	t, err := p.parse(s, 0, &d)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return d.result(t), nil
+}
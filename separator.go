@@ -0,0 +1,113 @@
+package parsetime
+
+import (
+	"strings"
+	"time"
+)
+
+// separators reports whether p accepts separators other than the RFC 3339 ones.
+func (p *Parser) separators() bool {
+	return p.DateSeparators != "" || p.TimeSeparators != "" || p.DateTimeSeparators != "" || p.BasicFormat
+}
+
+// parseSeparated parses s after replacing the separators accepted by p with "-", "T" and ":".
+func (p *Parser) parseSeparated(s []byte, locOffset int, d *details) (time.Time, error) {
+	var buf [64]byte
+	b := p.normalizeSeparators(buf[:0], s)
+
+	q := *p
+	q.DateSeparators, q.TimeSeparators, q.DateTimeSeparators, q.BasicFormat = "", "", "", false
+	return q.parse(b, locOffset, d)
+}
+
+// normalizeSeparators appends s to b with the separators of the date and time of day
+// replaced by "-", "T" and ":". The rest of s is appended unchanged, and so is all of s
+// when its separators are mixed.
+func (p *Parser) normalizeSeparators(b []byte, s []byte) []byte {
+	start, orig := len(b), s
+
+	// Year.
+	n := 4
+	if p.YearDigits > 0 && len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		for n = 1; n < len(s) && !nd(s[n]); n++ {
+		}
+	}
+	if len(s) <= n {
+		return append(b, s...)
+	}
+	b = append(b, s[:n]...)
+	s = s[n:]
+
+	// Month and day.
+	switch {
+	case len(s) >= 3 && p.dateSeparator(s[0]):
+		sep := s[0]
+		b = append(b, '-', s[1], s[2])
+		s = s[3:]
+		if len(s) > 0 {
+			if len(s) < 3 || s[0] != sep {
+				return append(b[:start], orig...)
+			}
+			b = append(b, '-', s[1], s[2])
+			s = s[3:]
+		}
+	case p.BasicFormat && len(s) >= 4 && !nd(s[0]) && !nd(s[1]) && !nd(s[2]) && !nd(s[3]):
+		b = append(b, '-', s[0], s[1], '-', s[2], s[3])
+		s = s[4:]
+	default:
+		return append(b, s...)
+	}
+	if len(s) == 0 {
+		return b
+	}
+
+	// Date and time separator, which may be omitted in basic format.
+	switch {
+	case p.dateTimeSeparator(s[0]):
+		s = s[1:]
+	case p.BasicFormat && !nd(s[0]):
+	default:
+		return append(b[:start], orig...)
+	}
+	if len(s) < 2 {
+		return append(b[:start], orig...)
+	}
+	b = append(b, 'T', s[0], s[1])
+	s = s[2:]
+
+	// Minute and second, which are followed by the fraction and time zone.
+	var sep byte
+	basic := false
+	for range 2 {
+		switch {
+		case !basic && len(s) >= 3 && p.timeSeparator(s[0]) && (sep == 0 || s[0] == sep) && !nd(s[1]):
+			sep = s[0]
+			b = append(b, ':', s[1], s[2])
+			s = s[3:]
+		case p.BasicFormat && sep == 0 && len(s) >= 2 && !nd(s[0]) && !nd(s[1]):
+			basic = true
+			b = append(b, ':', s[0], s[1])
+			s = s[2:]
+		case len(s) > 0 && s[0] == ':':
+			return append(b[:start], orig...)
+		default:
+			return append(b, s...)
+		}
+	}
+	return append(b, s...)
+}
+
+// dateSeparator reports whether c separates the year, month and day.
+func (p *Parser) dateSeparator(c byte) bool {
+	return c == '-' || strings.IndexByte(p.DateSeparators, c) >= 0
+}
+
+// timeSeparator reports whether c separates the hour, minute and second.
+func (p *Parser) timeSeparator(c byte) bool {
+	return c == ':' || strings.IndexByte(p.TimeSeparators, c) >= 0
+}
+
+// dateTimeSeparator reports whether c separates the date and the time of day.
+func (p *Parser) dateTimeSeparator(c byte) bool {
+	return c == 'T' || c == ' ' || strings.IndexByte(p.DateTimeSeparators, c) >= 0
+}
@@ -0,0 +1,68 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParserSeparators(t *testing.T) {
+	slash := Parser{DateSeparators: "/."}
+	file := Parser{TimeSeparators: "-", DateTimeSeparators: "_"}
+	basic := Parser{BasicFormat: true}
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{slash, "2006/01/02 15:04:05", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{slash, "2006.01.02 15:04:05.123+01:00", time.Date(2006, 1, 2, 14, 4, 5, 123000000, time.UTC), false},
+		{slash, "2006/01/02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{slash, "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{file, "2006-01-02_15-04-05", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{file, "2006-01-02_15-04-05-07:00", time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC), false},
+		{file, "2006-01-02 15:04:05", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{DateTimeSeparators: "t"}, "2006-01-02t15:04:05z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{basic, "20060102", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{basic, "20060102T150405Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{basic, "20060102T150405.5+0100", time.Date(2006, 1, 2, 14, 4, 5, 500000000, time.UTC), false},
+		{basic, "20060102150405", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{basic, "20060102 15:04:05", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{BasicFormat: true, ReducedPrecision: true}, "20060102T1504", time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC), false},
+		{Parser{DateSeparators: "/", ReducedPrecision: true}, "2006/01", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{Parser{DateSeparators: "/", YearDigits: 6}, "+012345/01/02", time.Date(12345, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{Parser{DateSeparators: "/", Abbreviations: true}, "2006/01/02 15:04:05 PST", time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC), false},
+
+		{Parser{}, "2006/01/02 15:04:05", time.Time{}, true},
+		{Parser{}, "20060102", time.Time{}, true},
+		{slash, "2006/01.02 15:04:05", time.Time{}, true},
+		{slash, "2006/01-02 15:04:05", time.Time{}, true},
+		{slash, "2006_01_02 15:04:05", time.Time{}, true},
+		{slash, "2006/01/02_15:04:05", time.Time{}, true},
+		{file, "2006-01-02_15-04:05", time.Time{}, true},
+		{file, "2006-01-02_15.04.05", time.Time{}, true},
+		{basic, "2006010", time.Time{}, true},
+		{basic, "20060102T15040", time.Time{}, true},
+		{basic, "200601021504051", time.Time{}, true},
+		{basic, "20060102T1504:05", time.Time{}, true},
+		{basic, "20061302", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package parsetime
+
+import (
+	"fmt"
+	"time"
+)
+
+var strictParser = Parser{Strict: true}
+
+// StrictError is returned for a text that is not a strict RFC 3339 date-time.
+type StrictError struct {
+	// Rule is the RFC 3339 ABNF rule that the text violates, such as "time-hour".
+	Rule string
+
+	// Offset is the byte offset in the text where the rule is violated.
+	Offset int
+}
+
+func (e *StrictError) Error() string {
+	return fmt.Sprintf("could not parse time: invalid RFC 3339 %s at offset %d", e.Rule, e.Offset)
+}
+
+// Unwrap returns the error of Parse for texts it does not accept.
+func (e *StrictError) Unwrap() error {
+	return errParse
+}
+
+// ParseStrict parses a strict RFC 3339 date-time, as in "2006-01-02T15:04:05.999999999+07:00",
+// rejecting the variants Parse accepts: a space or lowercase "t" separator, a comma before
+// the fraction, a lowercase "z", offsets without a colon or minutes, and a missing offset.
+// A text violating the grammar returns a *StrictError.
+func ParseStrict(s string) (time.Time, error) {
+	return strictParser.parse([]byte(s), 0, &details{})
+}
+
+// ParseStrictBytes is like ParseStrict but accepting bytes.
+func ParseStrictBytes(s []byte) (time.Time, error) {
+	return strictParser.parse(s, 0, &details{})
+}
+
+// parseStrict parses s as a strict RFC 3339 date-time, for the Strict option.
+func (p *Parser) parseStrict(s []byte, d *details) (time.Time, error) {
+	if err := checkRFC3339(s, p.LeapSecond != LeapSecondReject); err != nil {
+		return time.Time{}, err
+	}
+
+	// The offset is passed as the local offset of a time without one, since RFC 3339
+	// allows hours up to 23.
+	tzIdx, offset := len(s)-1, 0
+	if s[tzIdx] != 'Z' {
+		tzIdx = len(s) - 6
+		offset = int(s[tzIdx+1]-'0')*36000 + int(s[tzIdx+2]-'0')*3600 + int(s[tzIdx+4]-'0')*600 + int(s[tzIdx+5]-'0')*60
+		if s[tzIdx] == '-' {
+			offset = -offset
+		}
+	}
+
+	// The fraction may have any number of digits, which are truncated to nanoseconds
+	// unless rounded.
+	q := Parser{LeapSecond: p.LeapSecond, LeapSecondTable: p.LeapSecondTable, Fraction: p.Fraction}
+	if q.Fraction == FractionReject {
+		q.Fraction = FractionTruncate
+	}
+	t, err := q.parse(s[:tzIdx], offset, d)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	d.offset, d.zoned = offset, true
+	return t, nil
+}
+
+// checkRFC3339 returns a *StrictError if s is not an RFC 3339 date-time, with upper case
+// "T" and "Z". Second 60 is accepted if leapSecond is set.
+func checkRFC3339(s []byte, leapSecond bool) error {
+	// full-date
+	year, ok := strictDigits(s, 0, 4)
+	if !ok {
+		return &StrictError{"date-fullyear", 0}
+	}
+	if len(s) <= 4 || s[4] != '-' {
+		return &StrictError{"full-date", 4}
+	}
+	month, ok := strictDigits(s, 5, 2)
+	if !ok || month < 1 || month > 12 {
+		return &StrictError{"date-month", 5}
+	}
+	if len(s) <= 7 || s[7] != '-' {
+		return &StrictError{"full-date", 7}
+	}
+	if mday, ok := strictDigits(s, 8, 2); !ok || mday < 1 || mday > daysIn(month, year) {
+		return &StrictError{"date-mday", 8}
+	}
+
+	if len(s) <= 10 || s[10] != 'T' {
+		return &StrictError{"date-time", 10}
+	}
+
+	// partial-time
+	if hour, ok := strictDigits(s, 11, 2); !ok || hour > 23 {
+		return &StrictError{"time-hour", 11}
+	}
+	if len(s) <= 13 || s[13] != ':' {
+		return &StrictError{"partial-time", 13}
+	}
+	if minute, ok := strictDigits(s, 14, 2); !ok || minute > 59 {
+		return &StrictError{"time-minute", 14}
+	}
+	if len(s) <= 16 || s[16] != ':' {
+		return &StrictError{"partial-time", 16}
+	}
+	if second, ok := strictDigits(s, 17, 2); !ok || second > 60 || second == 60 && !leapSecond {
+		return &StrictError{"time-second", 17}
+	}
+
+	i := 19
+	if i < len(s) && s[i] == '.' {
+		i++
+		n := i
+		for i < len(s) && !nd(s[i]) {
+			i++
+		}
+		if i == n {
+			return &StrictError{"time-secfrac", i}
+		}
+	}
+
+	// time-offset
+	switch {
+	case i < len(s) && s[i] == 'Z':
+		i++
+	case i < len(s) && (s[i] == '+' || s[i] == '-'):
+		if hour, ok := strictDigits(s, i+1, 2); !ok || hour > 23 {
+			return &StrictError{"time-hour", i + 1}
+		}
+		if len(s) <= i+3 || s[i+3] != ':' {
+			return &StrictError{"time-numoffset", i + 3}
+		}
+		if minute, ok := strictDigits(s, i+4, 2); !ok || minute > 59 {
+			return &StrictError{"time-minute", i + 4}
+		}
+		i += 6
+	default:
+		return &StrictError{"time-offset", i}
+	}
+
+	if i != len(s) {
+		return &StrictError{"date-time", i}
+	}
+	return nil
+}
+
+// strictDigits parses the n decimal digits of s at i.
+func strictDigits(s []byte, i int, n int) (x int, ok bool) {
+	if len(s) < i+n {
+		return 0, false
+	}
+	for _, c := range s[i : i+n] {
+		if nd(c) {
+			return 0, false
+		}
+		x = x*10 + int(c-'0')
+	}
+	return x, true
+}
@@ -0,0 +1,155 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// strictCorpus is the RFC 3339 conformance corpus, of date-times that ParseStrict accepts,
+// and of texts it rejects with the rule they violate and where.
+var strictCorpus = []struct {
+	value  string
+	expect time.Time
+	rule   string
+	offset int
+}{
+	// Valid.
+	{"2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05+07:00", time.Date(2006, 1, 2, 8, 4, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05-07:00", time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05+00:00", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05-00:00", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05.1Z", time.Date(2006, 1, 2, 15, 4, 5, 100000000, time.UTC), "", 0},
+	{"2006-01-02T15:04:05.123456789Z", time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC), "", 0},
+	{"2006-01-02T15:04:05.123456789123+01:00", time.Date(2006, 1, 2, 14, 4, 5, 123456789, time.UTC), "", 0},
+	{"2006-01-02T15:04:05.000Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05+23:59", time.Date(2006, 1, 1, 15, 5, 5, 0, time.UTC), "", 0},
+	{"2006-01-02T15:04:05-23:59", time.Date(2006, 1, 3, 15, 3, 5, 0, time.UTC), "", 0},
+	{"0000-01-01T00:00:00Z", time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), "", 0},
+	{"9999-12-31T23:59:59.999999999Z", time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC), "", 0},
+	{"2000-02-29T00:00:00Z", time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), "", 0},
+	{"1985-04-12T23:20:50.52Z", time.Date(1985, 4, 12, 23, 20, 50, 520000000, time.UTC), "", 0},
+	{"1996-12-19T16:39:57-08:00", time.Date(1996, 12, 20, 0, 39, 57, 0, time.UTC), "", 0},
+	{"1937-01-01T12:00:27.87+00:20", time.Date(1937, 1, 1, 11, 40, 27, 870000000, time.UTC), "", 0},
+
+	// date-fullyear
+	{"", time.Time{}, "date-fullyear", 0},
+	{"206-01-02T15:04:05Z", time.Time{}, "date-fullyear", 0},
+	{"+2006-01-02T15:04:05Z", time.Time{}, "date-fullyear", 0},
+	{"2006", time.Time{}, "full-date", 4},
+	{"20060102T150405Z", time.Time{}, "full-date", 4},
+
+	// date-month and date-mday
+	{"2006-00-02T15:04:05Z", time.Time{}, "date-month", 5},
+	{"2006-13-02T15:04:05Z", time.Time{}, "date-month", 5},
+	{"2006-1-02T15:04:05Z", time.Time{}, "date-month", 5},
+	{"2006-01-00T15:04:05Z", time.Time{}, "date-mday", 8},
+	{"2006-01-32T15:04:05Z", time.Time{}, "date-mday", 8},
+	{"2006-02-29T15:04:05Z", time.Time{}, "date-mday", 8},
+	{"1900-02-29T15:04:05Z", time.Time{}, "date-mday", 8},
+	{"2006-04-31T15:04:05Z", time.Time{}, "date-mday", 8},
+	{"2006-01-2T15:04:05Z", time.Time{}, "date-mday", 8},
+
+	// date-time separator
+	{"2006-01-02", time.Time{}, "date-time", 10},
+	{"2006-01-02 15:04:05Z", time.Time{}, "date-time", 10},
+	{"2006-01-02t15:04:05Z", time.Time{}, "date-time", 10},
+	{"2006-01-02_15:04:05Z", time.Time{}, "date-time", 10},
+
+	// partial-time
+	{"2006-01-02T24:00:00Z", time.Time{}, "time-hour", 11},
+	{"2006-01-02T5:04:05Z", time.Time{}, "time-hour", 11},
+	{"2006-01-02T15", time.Time{}, "partial-time", 13},
+	{"2006-01-02T15:60:05Z", time.Time{}, "time-minute", 14},
+	{"2006-01-02T15:04", time.Time{}, "partial-time", 16},
+	{"2006-01-02T15:04Z", time.Time{}, "partial-time", 16},
+	{"2006-01-02T1504:05Z", time.Time{}, "partial-time", 13},
+	{"2006-01-02T15:04:61Z", time.Time{}, "time-second", 17},
+	{"2006-01-02T15:04:60Z", time.Time{}, "time-second", 17},
+	{"2006-01-02T15:04:5Z", time.Time{}, "time-second", 17},
+
+	// time-secfrac
+	{"2006-01-02T15:04:05.Z", time.Time{}, "time-secfrac", 20},
+	{"2006-01-02T15:04:05,123Z", time.Time{}, "time-offset", 19},
+
+	// time-offset
+	{"2006-01-02T15:04:05", time.Time{}, "time-offset", 19},
+	{"2006-01-02T15:04:05.123", time.Time{}, "time-offset", 23},
+	{"2006-01-02T15:04:05z", time.Time{}, "time-offset", 19},
+	{"2006-01-02T15:04:05 Z", time.Time{}, "time-offset", 19},
+	{"2006-01-02T15:04:05UTC", time.Time{}, "time-offset", 19},
+	{"2006-01-02T15:04:05+0700", time.Time{}, "time-numoffset", 22},
+	{"2006-01-02T15:04:05+07", time.Time{}, "time-numoffset", 22},
+	{"2006-01-02T15:04:05+7:00", time.Time{}, "time-hour", 20},
+	{"2006-01-02T15:04:05+24:00", time.Time{}, "time-hour", 20},
+	{"2006-01-02T15:04:05+07:60", time.Time{}, "time-minute", 23},
+	{"2006-01-02T15:04:05+07:0", time.Time{}, "time-minute", 23},
+
+	// Trailing text.
+	{"2006-01-02T15:04:05ZZ", time.Time{}, "date-time", 20},
+	{"2006-01-02T15:04:05+07:00 ", time.Time{}, "date-time", 25},
+	{"2006-01-02T15:04:05Z[Europe/Paris]", time.Time{}, "date-time", 20},
+}
+
+func TestParseStrict(t *testing.T) {
+	for i, tt := range strictCorpus {
+		got, err := ParseStrict(tt.value)
+		if tt.rule != "" {
+			var serr *StrictError
+			if !errors.As(err, &serr) || serr.Rule != tt.rule || serr.Offset != tt.offset {
+				t.Fatalf("case %d: got error: %v, expect: %s at %d, value: %s", i, err, tt.rule, tt.offset, tt.value)
+			}
+			if !errors.Is(err, errParse) {
+				t.Fatalf("case %d: got error: %v, expect: %v, value: %s", i, err, errParse, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserStrict(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{Strict: true, LeapSecond: LeapSecondRoll}, "2016-12-31T23:59:60Z", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{Parser{Strict: true, LeapSecond: LeapSecondClamp}, "2016-12-31T23:59:60.5Z", time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), false},
+		{Parser{Strict: true, LeapSecond: LeapSecondRoll, LeapSecondTable: true}, "2017-01-01T08:59:60+09:00", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{Parser{Strict: true, YearDigits: 6, ReducedPrecision: true, LenientOffsets: true}, "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+
+		{Parser{Strict: true}, "2016-12-31T23:59:60Z", time.Time{}, true},
+		{Parser{Strict: true, LeapSecond: LeapSecondRoll, LeapSecondTable: true}, "2016-12-30T23:59:60Z", time.Time{}, true},
+		{Parser{Strict: true, EndOfDay: true}, "2006-01-02T24:00:00Z", time.Time{}, true},
+		{Parser{Strict: true, YearDigits: 6}, "+012345-01-02T15:04:05Z", time.Time{}, true},
+		{Parser{Strict: true, ReducedPrecision: true}, "2006-01-02T15:04Z", time.Time{}, true},
+		{Parser{Strict: true, LenientOffsets: true}, "2006-01-02T15:04:05+8", time.Time{}, true},
+		{Parser{Strict: true, Abbreviations: true}, "2006-01-02T15:04:05 PST", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package parsetime
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// parseAnnotated parses an RFC 9557 suffix, optionally preceded by a numeric offset or Z,
+// as in "+01:00[Europe/Paris][u-ca=gregory]", returning its offset in seconds east of UTC.
+// The wall time is the time of day in seconds since the unix epoch, as if in UTC.
+func (p *Parser) parseAnnotated(s []byte, wall int64, d *details) (offset int, ok bool) {
+	i := indexByte(s, '[')
+	if i < 0 {
+		return 0, false
+	}
+
+	loc, critical, ok := parseSuffix(s[i:])
+	if !ok {
+		return 0, false
+	}
+
+	if i == 0 {
+		// Without an offset, the time is the wall time in the zone.
+		if loc == nil {
+			return 0, false
+		}
+		offset = wallOffset(loc, wall)
+	} else {
+		if offset, ok = p.atoiOffset(s[:i]); !ok {
+			return 0, false
+		}
+
+		// Z is a known instant in an unknown local time, so it is consistent with any zone.
+		if loc != nil && s[0] != 'Z' && s[0] != 'z' {
+			if _, zoneOffset := time.Unix(wall-int64(offset), 0).In(loc).Zone(); zoneOffset != offset && critical {
+				return 0, false
+			}
+		}
+	}
+
+	d.loc = loc
+	return offset, true
+}
+
+// parseSuffix parses the RFC 9557 suffix s, which is a sequence of bracketed tags, returning
+// the time zone it names, if any, and whether the time zone is critical.
+//
+// Elective tags that are not understood are ignored, and critical ones are rejected.
+// The only understood key is the calendar, u-ca, whose value must be gregory or iso8601.
+func parseSuffix(s []byte) (loc *time.Location, critical bool, ok bool) {
+	for first := true; len(s) > 0; first = false {
+		end := indexByte(s, ']')
+		if s[0] != '[' || end < 0 {
+			return nil, false, false
+		}
+		tag := s[1:end]
+		s = s[end+1:]
+
+		crit := len(tag) > 0 && tag[0] == '!'
+		if crit {
+			tag = tag[1:]
+		}
+
+		eq := indexByte(tag, '=')
+		if eq < 0 {
+			// A time zone, which may only be the first tag.
+			if !first {
+				return nil, false, false
+			}
+			if loc = suffixZone(tag); loc == nil {
+				return nil, false, false
+			}
+			critical = crit
+			continue
+		}
+
+		key, value := tag[:eq], tag[eq+1:]
+		if !validSuffixKey(key) || !validSuffixValue(value) {
+			return nil, false, false
+		}
+		if string(key) == "u-ca" {
+			if string(value) != "gregory" && string(value) != "iso8601" && crit {
+				return nil, false, false
+			}
+		} else if crit {
+			return nil, false, false
+		}
+	}
+
+	return loc, critical, true
+}
+
+// suffixZone returns the location of a time zone tag, which is either an IANA time zone name
+// or a numeric offset "+hh:mm", or nil if it is invalid.
+func suffixZone(tag []byte) *time.Location {
+	if len(tag) == 6 && (tag[0] == '+' || tag[0] == '-') && tag[3] == ':' {
+		offset, ok := atoiOffset(tag)
+		if !ok {
+			return nil
+		}
+		return time.FixedZone(string(tag), offset)
+	}
+
+	if !validZoneName(tag) {
+		return nil
+	}
+	loc, err := loadLocation(string(tag))
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+// validZoneName reports whether name is a syntactically valid IANA time zone name,
+// which is a sequence of parts separated by slashes.
+func validZoneName(name []byte) bool {
+	start := 0
+	for i := 0; i <= len(name); i++ {
+		if i < len(name) && name[i] != '/' {
+			continue
+		}
+		if !validZonePart(name[start:i]) {
+			return false
+		}
+		start = i + 1
+	}
+	return true
+}
+
+// validZonePart reports whether part is a valid part of an IANA time zone name.
+func validZonePart(part []byte) bool {
+	if len(part) == 0 || string(part) == "." || string(part) == ".." {
+		return false
+	}
+	for i, c := range part {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', c == '.', c == '_':
+		case i > 0 && ('0' <= c && c <= '9' || c == '-' || c == '+'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validSuffixKey reports whether key is a valid suffix key, such as "u-ca".
+func validSuffixKey(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for i, c := range key {
+		switch {
+		case 'a' <= c && c <= 'z', c == '_':
+		case i > 0 && ('0' <= c && c <= '9' || c == '-'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validSuffixValue reports whether value is a valid suffix value, which is a sequence of
+// alphanumeric parts separated by hyphens.
+func validSuffixValue(value []byte) bool {
+	start := 0
+	for i := 0; i <= len(value); i++ {
+		if i < len(value) && value[i] != '-' {
+			continue
+		}
+		if i == start {
+			return false
+		}
+		for _, c := range value[start:i] {
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9') {
+				return false
+			}
+		}
+		start = i + 1
+	}
+	return true
+}
+
+// indexByte is like bytes.IndexByte, which would keep the bytes of a string passed to
+// Parse from being shared rather than copied, since the compiler cannot tell that its
+// assembly does not modify them.
+func indexByte(s []byte, c byte) int {
+	for i := range s {
+		if s[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// locations caches the locations loaded by loadLocation, by name, and missingLocations
+// the errors of names that failed to load, up to maxMissingLocations of them.
+var (
+	locations        sync.Map
+	missingLocations sync.Map
+	missingCount     atomic.Int32
+)
+
+// maxMissingLocations bounds the names cached as missing, which may come from any text
+// following a time, such as the "INFO" of a log line.
+const maxMissingLocations = 1024
+
+// loadLocation is like time.LoadLocation, but caches the locations it loads and the
+// names it fails to load, which are shared by all Parsers. "Local" is rejected, since
+// it is the time zone of the host rather than one named by the text.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	if err, ok := missingLocations.Load(name); ok {
+		return nil, err.(error)
+	}
+	if name == "Local" {
+		return nil, errParse
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		if missingCount.Add(1) > maxMissingLocations {
+			// Forget the missing names rather than growing without bound.
+			missingLocations.Clear()
+			missingCount.Store(1)
+		}
+		missingLocations.Store(name, err)
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// wallOffset returns the offset of loc at the wall time, given in seconds since the unix
+// epoch as if in UTC, in seconds east of UTC. Like time.Date, a wall time skipped or
+// repeated by a transition takes the offset in effect before the transition.
+func wallOffset(loc *time.Location, wall int64) int {
+	_, offset := time.Unix(wall, 0).In(loc).Zone()
+	_, offset = time.Unix(wall-int64(offset), 0).In(loc).Zone()
+	return offset
+}
+
+// inZone returns the time at unix and nsec in loc, handling a leap second like parseClock.
+func (p *Parser) inZone(unix int64, nsec int, leapSec bool, loc *time.Location) (time.Time, error) {
+	if leapSec {
+		t, err := p.leapSecond(unix, nsec)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(loc), nil
+	}
+	return time.Unix(unix, int64(nsec)).In(loc), nil
+}
+
+// FormatIXDTF returns t in the RFC 9557 format, which is time.RFC3339Nano followed by
+// the name of t's location in brackets, as in "2006-01-02T15:04:05+01:00[Europe/Paris]".
+//
+// The location is omitted when it has no name or is time.Local, whose name is not known.
+func FormatIXDTF(t time.Time) string {
+	return string(AppendIXDTF(make([]byte, 0, 64), t))
+}
+
+// AppendIXDTF is like FormatIXDTF but appends the time to b and returns the extended buffer.
+func AppendIXDTF(b []byte, t time.Time) []byte {
+	b = t.AppendFormat(b, time.RFC3339Nano)
+
+	name := t.Location().String()
+	if name == "" || t.Location() == time.Local {
+		return b
+	}
+	b = append(b, '[')
+	b = append(b, name...)
+	return append(b, ']')
+}
@@ -0,0 +1,147 @@
+package parsetime
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParserAnnotations(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		value  string
+		expect time.Time
+		zone   string
+		err    error
+	}{
+		{"2026-10-16T14:00:00+02:00[Europe/Paris]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16T14:00:00.5+02:00[Europe/Paris]", time.Date(2026, 10, 16, 12, 0, 0, 500000000, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16 14:00:00+0200[Europe/Paris]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16T12:00:00Z[Europe/Paris]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16T14:00:00[Europe/Paris]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-01-16T14:00:00[Europe/Paris]", time.Date(2026, 1, 16, 13, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-03-29T02:30:00[Europe/Paris]", time.Date(2026, 3, 29, 2, 30, 0, 0, paris), "Europe/Paris", nil},
+		{"2026-10-25T02:30:00[Europe/Paris]", time.Date(2026, 10, 25, 2, 30, 0, 0, paris), "Europe/Paris", nil},
+		{"2026-10-16T14:00:00+05:00[Europe/Paris]", time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16T14:00:00+02:00[!Europe/Paris]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16T14:00:00+02:00[Europe/Paris][u-ca=gregory]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "Europe/Paris", nil},
+		{"2026-10-16T14:00:00+02:00[!u-ca=iso8601]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "", nil},
+		{"2026-10-16T14:00:00+02:00[u-ca=hebrew]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "", nil},
+		{"2026-10-16T14:00:00+02:00[x-foo=bar-baz][_y=1]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "", nil},
+		{"2026-10-16T14:00:00+02:00[+02:00]", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "+02:00", nil},
+		{"2026-10-16T14:00:00[-05:30]", time.Date(2026, 10, 16, 19, 30, 0, 0, time.UTC), "-05:30", nil},
+		{"2026-10-16T14:00:00Z[UTC]", time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC), "UTC", nil},
+
+		{"2026-10-16T14:00:00+05:00[!Europe/Paris]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[!u-ca=hebrew]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[!x-foo=bar]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[Europe/Paris][America/New_York]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[u-ca=gregory][Europe/Paris]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00[u-ca=gregory]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[Europe/Nowhere]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[../Europe/Paris]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[Local]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00[!Local]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[Europe/Paris", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[X-foo=bar]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[u-ca=]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00 [Europe/Paris]", time.Time{}, "", errParse},
+		{"2026-10-16T14:00:00+02:00[+24:00]", time.Time{}, "", errParse},
+	}
+
+	p := &Parser{Annotations: true}
+	for i, tt := range tests {
+		got, err := p.ParseResult(tt.value)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("case %d: got error: %v, expect: %v, value: %s", i, err, tt.err, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got.Time) || got.Zone != tt.zone {
+			t.Fatalf("case %d: got: %+v, expect: %+v %s, value: %s", i, got, tt.expect, tt.zone, tt.value)
+		}
+		if tt.zone != "" && got.Time.Location().String() != tt.zone {
+			t.Fatalf("case %d: got location: %s, expect: %s, value: %s", i, got.Time.Location(), tt.zone, tt.value)
+		}
+	}
+
+	if _, err := Parse("2026-10-16T14:00:00+02:00[Europe/Paris]"); err == nil {
+		t.Fatalf("got no error without Annotations")
+	}
+}
+
+func TestFormatIXDTF(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		value  time.Time
+		expect string
+	}{
+		{time.Date(2026, 10, 16, 14, 0, 0, 0, paris), "2026-10-16T14:00:00+02:00[Europe/Paris]"},
+		{time.Date(2026, 1, 16, 14, 0, 0, 500000000, paris), "2026-01-16T14:00:00.5+01:00[Europe/Paris]"},
+		{time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC), "2026-10-16T14:00:00Z[UTC]"},
+		{time.Date(2026, 10, 16, 14, 0, 0, 0, time.FixedZone("", 3600)), "2026-10-16T14:00:00+01:00"},
+		{time.Date(2026, 10, 16, 14, 0, 0, 0, time.FixedZone("+01:00", 3600)), "2026-10-16T14:00:00+01:00[+01:00]"},
+	}
+
+	p := &Parser{Annotations: true}
+	for i, tt := range tests {
+		got := FormatIXDTF(tt.value)
+		if got != tt.expect {
+			t.Fatalf("case %d: got: %s, expect: %s, value: %s", i, got, tt.expect, tt.value)
+		}
+
+		back, err := p.Parse(got)
+		if err != nil || !back.Equal(tt.value) {
+			t.Fatalf("case %d: got: %v %v, expect: %v, value: %s", i, back, err, tt.value, got)
+		}
+	}
+}
+
+func TestLoadLocationMissing(t *testing.T) {
+	p := &Parser{ZoneNames: true}
+	b := []byte("2006-01-02 15:04:05 Foo/Bar")
+
+	if _, err := p.ParseBytes(b); err == nil {
+		t.Fatalf("expect error got nil, value: %s", b)
+	}
+	if _, ok := missingLocations.Load("Foo/Bar"); !ok {
+		t.Fatalf("got: Foo/Bar not cached as missing")
+	}
+
+	// Cached misses do not reload the location, which allocates.
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := p.ParseBytes(b); err == nil {
+			t.Fatalf("expect error got nil, value: %s", b)
+		}
+	})
+	if allocs > 2 {
+		t.Fatalf("got: %v allocs, expect: at most 2", allocs)
+	}
+
+	for i := range 2 * maxMissingLocations {
+		loadLocation(fmt.Sprintf("Foo/Bar%d", i))
+	}
+	n := 0
+	missingLocations.Range(func(any, any) bool {
+		n++
+		return true
+	})
+	if n > maxMissingLocations {
+		t.Fatalf("got: %d missing locations, expect: at most %d", n, maxMissingLocations)
+	}
+}
@@ -0,0 +1,41 @@
+package parsetime
+
+// trim returns s without surrounding whitespace, and without matching quotes or brackets
+// around the rest, as in `"2006-01-02T15:04:05Z"` or "[2006-01-02 15:04:05,123]\r\n".
+func trim(s []byte) []byte {
+	for {
+		for len(s) > 0 && isSpace(s[0]) {
+			s = s[1:]
+		}
+		for len(s) > 0 && isSpace(s[len(s)-1]) {
+			s = s[:len(s)-1]
+		}
+
+		if len(s) < 2 || closing(s[0]) != s[len(s)-1] {
+			return s
+		}
+		s = s[1 : len(s)-1]
+	}
+}
+
+// isSpace reports whether c is ASCII whitespace.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// closing returns the byte closing the quote or bracket c, or 0 if c is neither.
+func closing(c byte) byte {
+	switch c {
+	case '"', '\'', '`':
+		return c
+	case '[':
+		return ']'
+	case '(':
+		return ')'
+	case '{':
+		return '}'
+	case '<':
+		return '>'
+	}
+	return 0
+}
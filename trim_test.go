@@ -0,0 +1,66 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParserTrim(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{Trim: true}, `"2006-01-02T15:04:05Z"`, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true}, `'2006-01-02T15:04:05Z'`, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true}, "[2006-01-02 15:04:05,123]", time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC), false},
+		{Parser{Trim: true}, "2006-01-02 15:04:05\r\n", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true}, " \t2006-01-02 \n", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{Parser{Trim: true}, ` ("2006-01-02T15:04:05+01:00") `, time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true}, "<2006-01-02T15:04:05Z>", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true}, "{ `2006-01-02T15:04:05Z` }", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true, Annotations: true}, "[2006-01-02T15:04:05Z[UTC]]", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{Parser{Trim: true, Strict: true}, " \"2006-01-02T15:04:05Z\"\n", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+
+		{Parser{}, `"2006-01-02T15:04:05Z"`, time.Time{}, true},
+		{Parser{}, "2006-01-02 15:04:05\r\n", time.Time{}, true},
+		{Parser{Trim: true}, `"2006-01-02T15:04:05Z'`, time.Time{}, true},
+		{Parser{Trim: true}, `"2006-01-02T15:04:05Z`, time.Time{}, true},
+		{Parser{Trim: true}, "[2006-01-02T15:04:05Z)", time.Time{}, true},
+		{Parser{Trim: true}, "[2006-01-02 15:04:05] x", time.Time{}, true},
+		{Parser{Trim: true}, `""`, time.Time{}, true},
+		{Parser{Trim: true}, " ", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserTrimAllocs(t *testing.T) {
+	p := &Parser{Trim: true}
+	b := []byte(" \"[2006-01-02T15:04:05.123456789+01:00]\"\r\n")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := p.ParseBytes(b); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("got: %v allocs, expect: 0", allocs)
+	}
+}
@@ -0,0 +1,68 @@
+package parsetime
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// parseUnicode parses s after replacing the Unicode forms of digits and punctuation
+// with their ASCII ones, for the Unicode option.
+func (p *Parser) parseUnicode(s []byte, locOffset int, d *details) (time.Time, error) {
+	var buf [64]byte
+	b := normalizeUnicode(buf[:0], s)
+
+	q := *p
+	q.Unicode = false
+	return q.parse(b, locOffset, d)
+}
+
+// isASCII reports whether s has only ASCII bytes.
+func isASCII(s []byte) bool {
+	for _, c := range s {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeUnicode appends s to b with full-width forms, Arabic-Indic and Persian digits,
+// minus signs, hyphens and non-breaking spaces replaced by ASCII, and bidirectional marks
+// removed. Other characters are appended unchanged.
+func normalizeUnicode(b []byte, s []byte) []byte {
+	for len(s) > 0 {
+		if s[0] < utf8.RuneSelf {
+			b = append(b, s[0])
+			s = s[1:]
+			continue
+		}
+
+		r, n := utf8.DecodeRune(s)
+		switch {
+		case r >= '\uFF01' && r <= '\uFF5E':
+			// Full-width forms of ASCII, as in "２０２６－１０－１６".
+			b = append(b, byte(r-'\uFF01'+'!'))
+		case r >= '\u0660' && r <= '\u0669':
+			// Arabic-Indic digits.
+			b = append(b, byte(r-'\u0660'+'0'))
+		case r >= '\u06F0' && r <= '\u06F9':
+			// Extended Arabic-Indic digits, used in Persian and Urdu.
+			b = append(b, byte(r-'\u06F0'+'0'))
+		case r == '\u2212' || r == '\u2010' || r == '\u2011':
+			// Minus sign, which ISO 8601 uses for negative offsets, and hyphens.
+			b = append(b, '-')
+		case r == '\u066B':
+			// Arabic decimal separator.
+			b = append(b, '.')
+		case r == '\u3000' || r == '\u00A0' || r == '\u202F':
+			// Ideographic and non-breaking spaces.
+			b = append(b, ' ')
+		case r == '\u200E' || r == '\u200F' || r == '\u061C':
+			// Bidirectional marks.
+		default:
+			b = append(b, s[:n]...)
+		}
+		s = s[n:]
+	}
+	return b
+}
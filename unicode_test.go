@@ -0,0 +1,67 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParserUnicode(t *testing.T) {
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{Parser{Unicode: true}, "２０２６－１０－１６", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), false},
+		{Parser{Unicode: true}, "２０２６－１０－１６Ｔ１４：３０：００Ｚ", time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), false},
+		{Parser{Unicode: true}, "２０２６－１０－１６　１４：３０：００．５＋０９：００", time.Date(2026, 10, 16, 5, 30, 0, 500000000, time.UTC), false},
+		{Parser{Unicode: true}, "٢٠٢٦-١٠-١٦ ١٤:٣٠:٠٠", time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), false},
+		{Parser{Unicode: true}, "۲۰۲۶-۱۰-۱۶T۱۴:۳۰:۰۰٫۵+۰۳:۳۰", time.Date(2026, 10, 16, 11, 0, 0, 500000000, time.UTC), false},
+		{Parser{Unicode: true}, "\u200f2026-10-16T14:30:00\u200e", time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), false},
+		{Parser{Unicode: true}, "2026-10-16T14:30:00−05:00", time.Date(2026, 10, 16, 19, 30, 0, 0, time.UTC), false},
+		{Parser{Unicode: true}, "2026‐10‑16 14:30:00", time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), false},
+		{Parser{Unicode: true}, "2026-10-16T14:30:00Z", time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), false},
+		{Parser{Unicode: true, Trim: true}, "（２０２６－１０－１６）", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), false},
+		{Parser{Unicode: true, Strict: true}, "２０２６－１０－１６Ｔ１４：３０：００Ｚ", time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), false},
+
+		{Parser{}, "２０２６－１０－１６", time.Time{}, true},
+		{Parser{}, "2026-10-16T14:30:00−05:00", time.Time{}, true},
+		{Parser{Unicode: true}, "２０２６－１０－３２", time.Time{}, true},
+		{Parser{Unicode: true}, "2026-10-16T14:30:00·05:00", time.Time{}, true},
+		{Parser{Unicode: true}, "२०२६-10-16", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestParserUnicodeAllocs(t *testing.T) {
+	p := &Parser{Unicode: true}
+	ascii := []byte("2026-10-16T14:30:00.123456789+09:00")
+	wide := []byte("２０２６－１０－１６Ｔ１４：３０：００Ｚ")
+
+	for _, b := range [][]byte{ascii, wide} {
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := p.ParseBytes(b); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Fatalf("got: %v allocs, expect: 0, value: %s", allocs, b)
+		}
+	}
+}
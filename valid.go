@@ -0,0 +1,79 @@
+package parsetime
+
+// Valid reports whether b is a time in a format Parse accepts, with the same checks of
+// the calendar, time of day and offset, but without computing the time.
+func Valid(b []byte) bool {
+	if len(b) == 10 {
+		return validDate(b)
+	}
+	return ValidDateTime(b)
+}
+
+// ValidRFC3339 reports whether b is a strict RFC 3339 date-time, like ParseStrict accepts.
+// Second 60 is also valid in the last minute of a UTC day, when a leap second may occur,
+// as in "2016-12-31T23:59:60Z" or "2016-12-31T15:59:60-08:00".
+func ValidRFC3339(b []byte) bool {
+	return checkRFC3339(b, true) == nil && (b[17] != '6' || leapMinute(b))
+}
+
+// leapMinute reports whether the RFC 3339 date-time b is in the last minute of a UTC day.
+func leapMinute(b []byte) bool {
+	minutes := (int(b[11]-'0')*10+int(b[12]-'0'))*60 + int(b[14]-'0')*10 + int(b[15]-'0')
+	if n := len(b); b[n-1] != 'Z' {
+		offset := (int(b[n-5]-'0')*10+int(b[n-4]-'0'))*60 + int(b[n-2]-'0')*10 + int(b[n-1]-'0')
+		if b[n-6] == '-' {
+			offset = -offset
+		}
+		minutes -= offset
+	}
+	return (minutes+2*24*60)%(24*60) == 24*60-1
+}
+
+// ValidDate reports whether b is a date, as in "2006-01-02".
+func ValidDate(b []byte) bool {
+	return len(b) == 10 && validDate(b)
+}
+
+// ValidDateTime reports whether b is a date and time in a format Parse accepts,
+// as in "2006-01-02 15:04:05" or "2006-01-02T15:04:05.999999999+07:00".
+func ValidDateTime(b []byte) bool {
+	if len(b) < 19 || !validDate(b[:10]) || b[10] != 'T' && b[10] != ' ' || b[13] != ':' || b[16] != ':' {
+		return false
+	}
+	if atoi2MinMax(b[11:13], 0, 23) == -1 || atoi2MinMax(b[14:16], 0, 59) == -1 || atoi2MinMax(b[17:19], 0, 59) == -1 {
+		return false
+	}
+
+	// Fraction of 1 to 9 digits.
+	s := b[19:]
+	if len(s) > 0 && (s[0] == '.' || s[0] == ',') {
+		n := 1
+		for n < len(s) && !nd(s[n]) {
+			n++
+		}
+		if n == 1 || n > 10 {
+			return false
+		}
+		s = s[n:]
+	}
+
+	if len(s) == 0 {
+		return true
+	}
+	_, ok := atoiOffset(s)
+	return ok
+}
+
+// validDate reports whether the 10 bytes of b are a date, as in "2006-01-02".
+func validDate(b []byte) bool {
+	if b[4] != '-' || b[7] != '-' || nd(b[0]) || nd(b[1]) || nd(b[2]) || nd(b[3]) {
+		return false
+	}
+	year := int(b[0]-'0')*1e3 + int(b[1]-'0')*1e2 + int(b[2]-'0')*1e1 + int(b[3]-'0')
+
+	month := atoi2MinMax(b[5:7], 1, 12)
+	if month == -1 {
+		return false
+	}
+	return atoi2MinMax(b[8:10], 1, daysIn(month, year)) != -1
+}
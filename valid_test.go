@@ -0,0 +1,100 @@
+package parsetime
+
+import (
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		value    string
+		date     bool
+		dateTime bool
+	}{
+		{"2006-01-02", true, false},
+		{"2000-02-29", true, false},
+		{"0000-01-01", true, false},
+		{"2006-01-02T15:04:05", false, true},
+		{"2006-01-02 15:04:05", false, true},
+		{"2006-01-02T15:04:05Z", false, true},
+		{"2006-01-02T15:04:05z", false, true},
+		{"2006-01-02T15:04:05.123456789+07:00", false, true},
+		{"2006-01-02 15:04:05,5-0700", false, true},
+		{"2006-01-02T15:04:05.1-07", false, true},
+		{"2006-01-02T23:59:59+14:00", false, true},
+
+		{"", false, false},
+		{"2006-1-02", false, false},
+		{"2006-01-2", false, false},
+		{"2006/01/02", false, false},
+		{"2006-00-02", false, false},
+		{"2006-13-02", false, false},
+		{"2006-01-00", false, false},
+		{"2006-01-32", false, false},
+		{"2006-02-29", false, false},
+		{"1900-02-29", false, false},
+		{"2006-04-31", false, false},
+		{"2006-01-02T", false, false},
+		{"2006-01-02T15:04", false, false},
+		{"2006-01-02t15:04:05", false, false},
+		{"2006-01-02T24:00:00", false, false},
+		{"2006-01-02T15:60:05", false, false},
+		{"2006-01-02T15:04:60", false, false},
+		{"2006-01-02T15:04:05.", false, false},
+		{"2006-01-02T15:04:05.Z", false, false},
+		{"2006-01-02T15:04:05.1234567890Z", false, false},
+		{"2006-01-02T15:04:05+15:00", false, false},
+		{"2006-01-02T15:04:05-13:00", false, false},
+		{"2006-01-02T15:04:05+07:60", false, false},
+		{"2006-01-02T15:04:05+7", false, false},
+		{"2006-01-02T15:04:05 ", false, false},
+		{"2006-01-02T15:04:05ZZ", false, false},
+		{"2006-01-02 PST", false, false},
+	}
+
+	for i, tt := range tests {
+		b := []byte(tt.value)
+		if got := ValidDate(b); got != tt.date {
+			t.Fatalf("case %d: got ValidDate: %t, expect: %t, value: %s", i, got, tt.date, tt.value)
+		}
+		if got := ValidDateTime(b); got != tt.dateTime {
+			t.Fatalf("case %d: got ValidDateTime: %t, expect: %t, value: %s", i, got, tt.dateTime, tt.value)
+		}
+
+		valid := tt.date || tt.dateTime
+		if got := Valid(b); got != valid {
+			t.Fatalf("case %d: got Valid: %t, expect: %t, value: %s", i, got, valid, tt.value)
+		}
+		if _, err := ParseBytes(b); (err == nil) != valid {
+			t.Fatalf("case %d: got Parse error: %v, expect valid: %t, value: %s", i, err, valid, tt.value)
+		}
+	}
+}
+
+func TestValidRFC3339(t *testing.T) {
+	for i, tt := range strictCorpus {
+		if got := ValidRFC3339([]byte(tt.value)); got != (tt.rule == "") {
+			t.Fatalf("case %d: got: %t, expect: %t, value: %s", i, got, tt.rule == "", tt.value)
+		}
+	}
+
+	tests := []struct {
+		value  string
+		expect bool
+	}{
+		{"2016-12-31T23:59:60Z", true},
+		{"1998-12-31T23:59:60.123Z", true},
+		{"1998-12-31T15:59:60.123-08:00", true},
+		{"2017-01-01T08:59:60+09:00", true},
+		{"2017-01-01T00:29:60+00:30", true},
+		{"1998-12-31T22:59:60Z", false},
+		{"1998-12-31T23:58:60Z", false},
+		{"1998-12-31T23:59:60+01:00", false},
+		{"1998-12-31T23:59:61Z", false},
+	}
+
+	for i, tt := range tests {
+		if got := ValidRFC3339([]byte(tt.value)); got != tt.expect {
+			t.Fatalf("case %d: got: %t, expect: %t, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
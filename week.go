@@ -0,0 +1,102 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// ParseWeek parses an ISO 8601 week date in the extended ("2006-W01-1") or
+// basic ("2006W011") format, optionally followed by a time of day and time zone
+// in any form accepted by Parse, as in "2006-W01-1T15:04:05Z".
+//
+// The day of week may be omitted, as in "2006-W01" or "2006W01", in which case
+// the result is the Monday of that week. Week 53 is only accepted in years
+// that have 53 ISO weeks.
+//
+// In the absence of time zone information, ParseWeek interprets the time as in UTC.
+func ParseWeek(s string) (time.Time, error) {
+	return parseWeek([]byte(s), 0)
+}
+
+// ParseWeekBytes is like ParseWeek but accepting bytes.
+func ParseWeekBytes(s []byte) (time.Time, error) {
+	return parseWeek(s, 0)
+}
+
+// FormatWeek returns the ISO 8601 extended week date of t, such as "2006-W01-1".
+func FormatWeek(t time.Time) string {
+	return string(AppendWeek(make([]byte, 0, 10), t))
+}
+
+// AppendWeek is like FormatWeek but appends the week date to b and returns the extended buffer.
+func AppendWeek(b []byte, t time.Time) []byte {
+	year, week := t.ISOWeek()
+	wd := int(t.Weekday())
+	if wd == 0 {
+		wd = 7
+	}
+
+	b = appendInt(b, year, 4)
+	b = append(b, '-', 'W', byte('0'+week/10), byte('0'+week%10), '-', byte('0'+wd))
+	return b
+}
+
+func parseWeek(s []byte, locOffset int) (time.Time, error) {
+	sLen := len(s)
+
+	if sLen < 7 || nd(s[0]) || nd(s[1]) || nd(s[2]) || nd(s[3]) {
+		return time.Time{}, errParse
+	}
+	year := int(s[0]-'0')*1e3 + int(s[1]-'0')*1e2 + int(s[2]-'0')*1e1 + int(s[3]-'0')
+
+	// Extended format has a hyphen before the week and before the day.
+	extended := s[4] == '-'
+	i := 4
+	if extended {
+		i++
+	}
+
+	if i+3 > sLen || s[i] != 'W' {
+		return time.Time{}, errParse
+	}
+	week := atoi2MinMax(s[i+1:i+3], 1, 53)
+	if week == -1 || week == 53 && !isLongYear(year) {
+		return time.Time{}, errParse
+	}
+	i += 3
+
+	// Day of week, Monday = 1.
+	wd := 1
+	switch {
+	case i == sLen:
+	case extended && s[i] == '-' || !extended && !nd(s[i]):
+		if extended {
+			i++
+		}
+		if i == sLen || s[i] < '1' || s[i] > '7' {
+			return time.Time{}, errParse
+		}
+		wd = int(s[i] - '0')
+		i++
+	default:
+		// A time requires the day of week.
+		return time.Time{}, errParse
+	}
+
+	// Week 1 is the week with the year's first Thursday, that is, the week containing January 4.
+	jan4 := daysSinceEpoch(year) + 3
+	daysEpoc := jan4 - uint64((weekday(jan4)+6)%7) + uint64((week-1)*7+wd-1)
+
+	return defaultParser.parseClock(s[i:], daysEpoc, locOffset, &details{})
+}
+
+// isLongYear reports whether the ISO 8601 week-numbering year has 53 weeks,
+// which is the case when it starts on a Thursday, or on a Wednesday in a leap year.
+func isLongYear(year int) bool {
+	wd := weekday(daysSinceEpoch(year))
+	return wd == int(time.Thursday) || wd == int(time.Wednesday) && isLeap(year)
+}
+
+// weekday returns the day of week of days since the absolute epoch, which was a Monday.
+func weekday(days uint64) int {
+	return int((days + uint64(time.Monday)) % 7)
+}
@@ -0,0 +1,77 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWeek(t *testing.T) {
+	tests := []struct {
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{"2026-W42-5", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), false},
+		{"2026W425", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), false},
+		{"2026-W42", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), false},
+		{"2026W42", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), false},
+		{"2009-W01-1", time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC), false},
+		{"2009-W53-7", time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC), false},
+		{"2020-W53-5", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2026-W53-1", time.Date(2026, 12, 28, 0, 0, 0, 0, time.UTC), false},
+		{"2004-W53-6", time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2026-W42-5T15:04:05Z", time.Date(2026, 10, 16, 15, 4, 5, 0, time.UTC), false},
+		{"2026W425T15:04:05.123+08:00", time.Date(2026, 10, 16, 7, 4, 5, 123000000, time.UTC), false},
+		{"2026-W42-5 15:04:05", time.Date(2026, 10, 16, 15, 4, 5, 0, time.UTC), false},
+
+		{"2025-W53-1", time.Time{}, true},
+		{"2019-W53", time.Time{}, true},
+		{"2026-W00-1", time.Time{}, true},
+		{"2026-W54-1", time.Time{}, true},
+		{"2026-W42-0", time.Time{}, true},
+		{"2026-W42-8", time.Time{}, true},
+		{"2026-W425", time.Time{}, true},
+		{"2026W42-5", time.Time{}, true},
+		{"2026-W42T15:04:05Z", time.Time{}, true},
+		{"2026-W42-5T15:04", time.Time{}, true},
+		{"2026-w42-5", time.Time{}, true},
+		{"2026-W4", time.Time{}, true},
+		{"26-W42-5", time.Time{}, true},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseWeek(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+}
+
+func TestFormatWeek(t *testing.T) {
+	start := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day := start; day.Year() < 2040; day = day.AddDate(0, 0, 1) {
+		s := FormatWeek(day)
+
+		got, err := ParseWeek(s)
+		if err != nil {
+			t.Fatalf("%s: got error: %s, value: %s", day, err, s)
+		}
+		if !got.Equal(day) {
+			t.Fatalf("%s: got: %+v, value: %s", day, got, s)
+		}
+	}
+
+	if s := FormatWeek(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)); s != "2026-W42-7" {
+		t.Fatalf("got: %s, expect: 2026-W42-7", s)
+	}
+}
@@ -0,0 +1,43 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// window reports whether p limits the accepted times.
+func (p *Parser) window() bool {
+	return !p.NotBefore.IsZero() || !p.NotAfter.IsZero() || p.MaxPast != 0 || p.MaxFuture != 0
+}
+
+// parseWindow parses s, returning ErrOutOfWindow for a time outside the window of p.
+func (p *Parser) parseWindow(s []byte, locOffset int, d *details) (time.Time, error) {
+	q := *p
+	q.NotBefore, q.NotAfter, q.MaxPast, q.MaxFuture = time.Time{}, time.Time{}, 0, 0
+
+	t, err := q.parse(s, locOffset, d)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !p.inWindow(t) {
+		return time.Time{}, ErrOutOfWindow
+	}
+	return t, nil
+}
+
+// inWindow reports whether t is within the window of p.
+func (p *Parser) inWindow(t time.Time) bool {
+	if !p.NotBefore.IsZero() && t.Before(p.NotBefore) || !p.NotAfter.IsZero() && t.After(p.NotAfter) {
+		return false
+	}
+	if p.MaxPast == 0 && p.MaxFuture == 0 {
+		return true
+	}
+
+	var now time.Time
+	if p.Clock != nil {
+		now = p.Clock()
+	} else {
+		now = time.Now()
+	}
+	return (p.MaxPast == 0 || !t.Before(now.Add(-p.MaxPast))) && (p.MaxFuture == 0 || !t.After(now.Add(p.MaxFuture)))
+}
@@ -0,0 +1,78 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParserWindow(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	absolute := Parser{NotBefore: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), NotAfter: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
+	relative := Parser{MaxPast: 365 * 24 * time.Hour, MaxFuture: 24 * time.Hour, Clock: clock}
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		err    error
+	}{
+		{absolute, "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), nil},
+		{absolute, "2000-01-01T00:00:00Z", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{absolute, "2100-01-01T01:00:00+01:00", time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{relative, "2026-10-17T12:00:00Z", time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC), nil},
+		{relative, "2025-10-16T12:00:00Z", time.Date(2025, 10, 16, 12, 0, 0, 0, time.UTC), nil},
+		{Parser{MaxFuture: time.Hour, Clock: clock}, "1970-01-01T00:00:00Z", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{Parser{NotAfter: now, Trim: true, DateSeparators: "/"}, ` "2026/10/16 12:00:00Z" `, now, nil},
+		{Parser{NotBefore: now, Strict: true}, "2026-10-16T12:00:00Z", now, nil},
+
+		{absolute, "0001-01-01", time.Time{}, ErrOutOfWindow},
+		{absolute, "9999-12-31", time.Time{}, ErrOutOfWindow},
+		{absolute, "1999-12-31T23:59:59.999999999Z", time.Time{}, ErrOutOfWindow},
+		{absolute, "2100-01-01T00:00:00-00:01", time.Time{}, ErrOutOfWindow},
+		{relative, "2026-10-17T12:00:00.000000001Z", time.Time{}, ErrOutOfWindow},
+		{relative, "2025-10-16T11:59:59Z", time.Time{}, ErrOutOfWindow},
+		{relative, "1970-01-01T00:00:00Z", time.Time{}, ErrOutOfWindow},
+		{Parser{NotBefore: now, Strict: true}, "2026-10-16T11:59:59Z", time.Time{}, ErrOutOfWindow},
+		{absolute, "2006-13-02", time.Time{}, errParse},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.Parse(tt.value)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("case %d: got error: %v, expect: %v, value: %s", i, err, tt.err, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got) {
+			t.Fatalf("case %d: got: %+v, expect: %+v, value: %s", i, got, tt.expect, tt.value)
+		}
+	}
+
+	// Times are also checked by the other parsing functions of a Parser.
+	if _, _, err := relative.ParsePrefix([]byte("1970-01-01T00:00:00Z INFO")); err != ErrOutOfWindow {
+		t.Fatalf("got error: %v, expect: %v", err, ErrOutOfWindow)
+	}
+	if ms := relative.FindAll([]byte("at 1970-01-01, 2026-W42-5 and 2026-10-16T08:00:00Z")); len(ms) != 2 || ms[0].Format != MatchWeek {
+		t.Fatalf("got: %+v, expect: 2026-W42-5 and 2026-10-16T08:00:00Z", ms)
+	}
+	if _, _, err := relative.ParseRange("1970-01"); err != ErrOutOfWindow {
+		t.Fatalf("got error: %v, expect: %v", err, ErrOutOfWindow)
+	}
+	if _, _, err := absolute.ParseRangeInLocation("2000-01-01", time.FixedZone("", 3600)); err != ErrOutOfWindow {
+		t.Fatalf("got error: %v, expect: %v", err, ErrOutOfWindow)
+	}
+	if _, err := relative.ParseIncomplete("Jan 02 15:04:05", now.AddDate(-2, 0, 0), CompleteNearest); err != ErrOutOfWindow {
+		t.Fatalf("got error: %v, expect: %v", err, ErrOutOfWindow)
+	}
+	if _, err := relative.ParseInstant("2026-10-18T00:00:00.000000000001Z"); err != ErrOutOfWindow {
+		t.Fatalf("got error: %v, expect: %v", err, ErrOutOfWindow)
+	}
+}
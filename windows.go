@@ -0,0 +1,10 @@
+package parsetime
+
+//go:generate go run gen_windowszones.go -release 47 windowsZones.xml
+
+// WindowsZone returns the IANA time zone name of a Windows time zone name, such as
+// "America/Los_Angeles" for "Pacific Standard Time", using the embedded CLDR mapping.
+func WindowsZone(name string) (iana string, ok bool) {
+	iana, ok = windowsZones[name]
+	return iana, ok
+}
@@ -0,0 +1,90 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowsZone(t *testing.T) {
+	tests := []struct {
+		value  string
+		expect string
+		ok     bool
+	}{
+		{"China Standard Time", "Asia/Shanghai", true},
+		{"Pacific Standard Time", "America/Los_Angeles", true},
+		{"Pacific Standard Time (Mexico)", "America/Tijuana", true},
+		{"W. Europe Standard Time", "Europe/Berlin", true},
+		{"UTC", "Etc/UTC", true},
+		{"UTC+12", "Etc/GMT-12", true},
+
+		{"china standard time", "", false},
+		{"Pacific Standard Time ", "", false},
+		{"America/Los_Angeles", "", false},
+		{"", "", false},
+	}
+
+	for i, tt := range tests {
+		got, ok := WindowsZone(tt.value)
+		if got != tt.expect || ok != tt.ok {
+			t.Fatalf("case %d: got: %s %t, expect: %s %t, value: %s", i, got, ok, tt.expect, tt.ok, tt.value)
+		}
+	}
+}
+
+func TestWindowsZonesLoad(t *testing.T) {
+	if _, err := time.LoadLocation("Asia/Shanghai"); err != nil {
+		t.Skip(err)
+	}
+
+	for name, iana := range windowsZones {
+		if _, err := time.LoadLocation(iana); err != nil {
+			t.Fatalf("got error: %s, value: %s", err, name)
+		}
+	}
+}
+
+func TestParserWindowsZones(t *testing.T) {
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		p      Parser
+		value  string
+		expect time.Time
+		zone   string
+		err    bool
+	}{
+		{Parser{WindowsZones: true}, "2026-07-01 12:00:00 China Standard Time", time.Date(2026, 7, 1, 4, 0, 0, 0, time.UTC), "Asia/Shanghai", false},
+		{Parser{WindowsZones: true}, "2026-07-01 12:00:00 Pacific Standard Time", time.Date(2026, 7, 1, 19, 0, 0, 0, time.UTC), "America/Los_Angeles", false},
+		{Parser{WindowsZones: true}, "2026-01-01T12:00:00.5 Pacific Standard Time", time.Date(2026, 1, 1, 20, 0, 0, 500000000, time.UTC), "America/Los_Angeles", false},
+		{Parser{WindowsZones: true}, "2026-03-08 02:30:00 Pacific Standard Time", time.Date(2026, 3, 8, 2, 30, 0, 0, losAngeles), "America/Los_Angeles", false},
+		{Parser{WindowsZones: true}, "2026-07-01 12:00:00 India Standard Time", time.Date(2026, 7, 1, 6, 30, 0, 0, time.UTC), "Asia/Calcutta", false},
+		{Parser{WindowsZones: true, ZoneNames: true}, "2026-07-01 12:00:00 Asia/Tokyo", time.Date(2026, 7, 1, 3, 0, 0, 0, time.UTC), "Asia/Tokyo", false},
+
+		{Parser{}, "2026-07-01 12:00:00 China Standard Time", time.Time{}, "", true},
+		{Parser{ZoneNames: true}, "2026-07-01 12:00:00 China Standard Time", time.Time{}, "", true},
+		{Parser{WindowsZones: true}, "2026-07-01 12:00:00 Asia/Tokyo", time.Time{}, "", true},
+		{Parser{WindowsZones: true}, "2026-07-01 12:00:00 China  Standard Time", time.Time{}, "", true},
+		{Parser{WindowsZones: true}, "2026-07-01 12:00:00China Standard Time", time.Time{}, "", true},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.p.ParseResult(tt.value)
+		if tt.err {
+			if err == nil {
+				t.Fatalf("case %d: expect error got nil, value: %s", i, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: got error: %s, value: %s", i, err, tt.value)
+		}
+
+		if !tt.expect.Equal(got.Time) || got.Zone != tt.zone {
+			t.Fatalf("case %d: got: %+v, expect: %+v %s, value: %s", i, got, tt.expect, tt.zone, tt.value)
+		}
+	}
+}
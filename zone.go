@@ -0,0 +1,254 @@
+package parsetime
+
+import (
+	"bytes"
+)
+
+// namedZones reports whether p accepts time zones other than numeric offsets and Z.
+func (p *Parser) namedZones() bool {
+	return p.LenientOffsets || p.Abbreviations || p.Annotations || p.ZoneNames || p.WindowsZones
+}
+
+// parseZone parses a time zone other than a numeric offset or Z, following a time,
+// returning its offset in seconds east of UTC.
+// The wall time is the time of day in seconds since the unix epoch, as if in UTC.
+func (p *Parser) parseZone(s []byte, wall int64, d *details) (offset int, ok bool) {
+	if p.LenientOffsets {
+		if offset, ok = atoiLenientOffset(s); ok {
+			return offset, true
+		}
+	}
+
+	if p.Annotations {
+		if offset, ok = p.parseAnnotated(s, wall, d); ok {
+			return offset, true
+		}
+	}
+
+	if p.Abbreviations {
+		name, attached := s, s[0] != ' '
+		if !attached {
+			name = name[1:]
+		}
+		if offset, ok = p.lookupAbbreviation(name, attached); ok {
+			return offset, true
+		}
+	}
+
+	if p.WindowsZones && s[0] == ' ' {
+		if name, ok := windowsZones[string(s[1:])]; ok {
+			if loc, err := loadLocation(name); err == nil {
+				d.loc = loc
+				return wallOffset(loc, wall), true
+			}
+		}
+	}
+
+	if p.ZoneNames && s[0] == ' ' && validZoneName(s[1:]) {
+		if loc, err := loadLocation(string(s[1:])); err == nil {
+			d.loc = loc
+			return wallOffset(loc, wall), true
+		}
+	}
+
+	return 0, false
+}
+
+// atoiOffset is like the function atoiOffset, also accepting the forms of LenientOffsets
+// if enabled.
+func (p *Parser) atoiOffset(s []byte) (offset int, ok bool) {
+	if offset, ok = atoiOffset(s); !ok && p.LenientOffsets {
+		offset, ok = atoiLenientOffset(s)
+	}
+	return offset, ok
+}
+
+// atoiLenientOffset parses a time zone offset of the forms accepted by LenientOffsets,
+// which is "UTC", "GMT" or "UT", or a sign followed by h, hh, hhmm, hhmmss, h:mm,
+// hh:mm, h:mm:ss or hh:mm:ss, optionally after one of those names and one space,
+// returning its offset in seconds east of UTC.
+func atoiLenientOffset(s []byte) (offset int, ok bool) {
+	if len(s) > 0 && s[0] == ' ' {
+		s = s[1:]
+	}
+
+	named := true
+	switch {
+	case bytes.HasPrefix(s, []byte("UTC")), bytes.HasPrefix(s, []byte("GMT")):
+		s = s[3:]
+	case bytes.HasPrefix(s, []byte("UT")):
+		s = s[2:]
+	default:
+		named = false
+	}
+	if len(s) == 0 {
+		return 0, named
+	}
+
+	sign := 1
+	switch s[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, false
+	}
+	s = s[1:]
+
+	h, m, sec := -1, 0, 0
+	if i := indexByte(s, ':'); i >= 0 {
+		// Extended format.
+		if len(s) != i+3 && (len(s) != i+6 || s[i+3] != ':') {
+			return 0, false
+		}
+		h, m = atoiHour(s[:i]), atoi2MinMax(s[i+1:i+3], 0, 59)
+		if len(s) == i+6 {
+			sec = atoi2MinMax(s[i+4:], 0, 59)
+		}
+	} else {
+		// Basic format.
+		switch len(s) {
+		case 1, 2:
+			h = atoiHour(s)
+		case 4:
+			h, m = atoiHour(s[:2]), atoi2MinMax(s[2:4], 0, 59)
+		case 6:
+			h, m, sec = atoiHour(s[:2]), atoi2MinMax(s[2:4], 0, 59), atoi2MinMax(s[4:6], 0, 59)
+		}
+	}
+
+	if h == -1 || m == -1 || sec == -1 {
+		return 0, false
+	}
+	return sign * (h*secondsPerHour + m*secondsPerMinute + sec), true
+}
+
+// atoiHour parses an hour of one or two digits, from 0 to 23, returning -1 if invalid.
+func atoiHour(s []byte) int {
+	if len(s) == 1 {
+		if nd(s[0]) {
+			return -1
+		}
+		return int(s[0] - '0')
+	}
+	return atoi2MinMax(s, 0, 23)
+}
+
+// lookupAbbreviation returns the offset of a time zone abbreviation, or of a military
+// zone letter if military is set, which is only when it is attached to the time, since
+// a letter after a space is more likely a word, as in "15:04:05 A lot".
+func (p *Parser) lookupAbbreviation(name []byte, military bool) (offset int, ok bool) {
+	if offset, ok = p.AbbreviationOffsets[string(name)]; ok {
+		return offset, true
+	}
+	if len(name) == 0 || len(name) > 5 {
+		return 0, false
+	}
+
+	if len(name) == 1 {
+		if !military {
+			return 0, false
+		}
+		return militaryOffset(name[0])
+	}
+
+	a, ok := abbreviations[string(name)]
+	if !ok || a.ambiguous && p.StrictAbbreviations {
+		return 0, false
+	}
+	return a.offset, true
+}
+
+// militaryOffset returns the offset of a military time zone letter, from A (+1) to M (+12)
+// skipping J, which is local time, and from N (-1) to Y (-12), with Z being UTC.
+func militaryOffset(c byte) (offset int, ok bool) {
+	switch {
+	case c >= 'A' && c <= 'I':
+		return int(c-'A'+1) * secondsPerHour, true
+	case c >= 'K' && c <= 'M':
+		return int(c-'K'+10) * secondsPerHour, true
+	case c >= 'N' && c <= 'Y':
+		return -int(c-'N'+1) * secondsPerHour, true
+	case c == 'Z':
+		return 0, true
+	}
+	return 0, false
+}
+
+type abbreviation struct {
+	offset int
+
+	// ambiguous is set when the abbreviation has other meanings than offset,
+	// which is its most common one.
+	ambiguous bool
+}
+
+// abbreviations are common time zone abbreviations.
+var abbreviations = map[string]abbreviation{
+	// Universal.
+	"UTC": {0, false},
+	"UT":  {0, false},
+	"GMT": {0, false},
+
+	// North America.
+	"HST":  {-10 * secondsPerHour, false},
+	"HDT":  {-9 * secondsPerHour, false},
+	"AKST": {-9 * secondsPerHour, false},
+	"AKDT": {-8 * secondsPerHour, false},
+	"PST":  {-8 * secondsPerHour, false},
+	"PDT":  {-7 * secondsPerHour, false},
+	"MST":  {-7 * secondsPerHour, false},
+	"MDT":  {-6 * secondsPerHour, false},
+	"CST":  {-6 * secondsPerHour, true}, // China Standard Time +8, Cuba Standard Time -5
+	"CDT":  {-5 * secondsPerHour, true}, // Cuba Daylight Time -4
+	"EST":  {-5 * secondsPerHour, false},
+	"EDT":  {-4 * secondsPerHour, false},
+	"AST":  {-4 * secondsPerHour, true}, // Arabia Standard Time +3
+	"ADT":  {-3 * secondsPerHour, false},
+	"NST":  {-3*secondsPerHour - 30*secondsPerMinute, false},
+	"NDT":  {-2*secondsPerHour - 30*secondsPerMinute, false},
+
+	// South America.
+	"BRT": {-3 * secondsPerHour, false},
+	"ART": {-3 * secondsPerHour, false},
+	"CLT": {-4 * secondsPerHour, false},
+
+	// Europe and Africa.
+	"WET":  {0, false},
+	"WEST": {1 * secondsPerHour, false},
+	"BST":  {1 * secondsPerHour, true},                     // Bangladesh Standard Time +6
+	"IST":  {5*secondsPerHour + 30*secondsPerMinute, true}, // Irish Standard Time +1, Israel Standard Time +2
+	"CET":  {1 * secondsPerHour, false},
+	"CEST": {2 * secondsPerHour, false},
+	"MET":  {1 * secondsPerHour, false},
+	"MEST": {2 * secondsPerHour, false},
+	"EET":  {2 * secondsPerHour, false},
+	"EEST": {3 * secondsPerHour, false},
+	"MSK":  {3 * secondsPerHour, false},
+	"IDT":  {3 * secondsPerHour, false},
+	"WAT":  {1 * secondsPerHour, false},
+	"CAT":  {2 * secondsPerHour, false},
+	"SAST": {2 * secondsPerHour, false},
+	"EAT":  {3 * secondsPerHour, false},
+
+	// Asia.
+	"PKT":  {5 * secondsPerHour, false},
+	"NPT":  {5*secondsPerHour + 45*secondsPerMinute, false},
+	"ICT":  {7 * secondsPerHour, false},
+	"WIB":  {7 * secondsPerHour, false},
+	"HKT":  {8 * secondsPerHour, false},
+	"SGT":  {8 * secondsPerHour, false},
+	"PHT":  {8 * secondsPerHour, false},
+	"AWST": {8 * secondsPerHour, false},
+	"JST":  {9 * secondsPerHour, false},
+	"KST":  {9 * secondsPerHour, false},
+
+	// Oceania.
+	"ACST": {9*secondsPerHour + 30*secondsPerMinute, false},
+	"ACDT": {10*secondsPerHour + 30*secondsPerMinute, false},
+	"AEST": {10 * secondsPerHour, false},
+	"AEDT": {11 * secondsPerHour, false},
+	"NZST": {12 * secondsPerHour, false},
+	"NZDT": {13 * secondsPerHour, false},
+	"SST":  {-11 * secondsPerHour, true}, // Singapore Standard Time +8
+}